@@ -13,7 +13,7 @@ func main() {
 	signal.Notify(interrupt, os.Interrupt)
 
 	client := nwsclient.WSClient{}
-	client.Init(nil)
+	client.Init(nil, nil)
 
 	mychan := make(chan pb.SubscriptionEntry)
 
@@ -17,6 +17,7 @@
  */
 
 //go:generate protoc -I ../../nanoproto --go_out=plugins=grpc:../../nanoproto ../../nanoproto/nano.proto
+//go:generate protoc -I ../../nanoproto --go_out=plugins=grpc,paths=source_relative:../../nanoproto ../../nanoproto/v2/nano.proto
 
 // Package main implements a Server for Greeter service.
 package main
@@ -27,9 +28,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/akamensky/argparse"
+	"github.com/alvistar/nanopb/internal/nwsclient"
 	"github.com/alvistar/nanopb/internal/pbserver"
 	"github.com/alvistar/nanopb/internal/usclient"
 	pb "github.com/alvistar/nanopb/nanoproto"
+	pbv2 "github.com/alvistar/nanopb/nanoproto/v2"
 	nested "github.com/antonfisher/nested-logrus-formatter"
 	log "github.com/sirupsen/logrus"
 	"github.com/zput/zxcTool/ztLog/zt_formatter"
@@ -37,9 +40,14 @@ import (
 	"google.golang.org/grpc/credentials"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"path"
 	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
 )
 
 func AppendCertsFromFile(pool *x509.CertPool, fileName string) error {
@@ -58,6 +66,113 @@ func AppendCertsFromFile(pool *x509.CertPool, fileName string) error {
 	return nil
 }
 
+// parseHeaders converts "Name: value" arguments into a header map
+func parseHeaders(list []string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, h := range list {
+		kv := strings.SplitN(h, ":", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid header %q, expecting \"Name: value\"", h)
+		}
+		headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return headers, nil
+}
+
+// parseNodes converts "URI[,weight[,role]]" arguments into node endpoints
+func parseNodes(list []string) ([]usclient.ConfEndpoint, error) {
+	nodes := make([]usclient.ConfEndpoint, 0)
+	for _, n := range list {
+		fields := strings.Split(n, ",")
+		if len(fields) > 3 || strings.TrimSpace(fields[0]) == "" {
+			return nil, fmt.Errorf("invalid node %q, expecting \"URI[,weight[,role]]\"", n)
+		}
+
+		node := usclient.ConfEndpoint{Connection: strings.TrimSpace(fields[0]), Role: usclient.RoleSecondary}
+
+		if len(fields) > 1 {
+			weight, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+			if err != nil || weight <= 0 {
+				return nil, fmt.Errorf("invalid weight in node %q", n)
+			}
+			node.Weight = weight
+		}
+
+		if len(fields) > 2 {
+			node.Role = strings.TrimSpace(fields[2])
+			if node.Role != usclient.RolePrimary && node.Role != usclient.RoleSecondary {
+				return nil, fmt.Errorf("invalid role in node %q, expecting %s or %s",
+					n, usclient.RolePrimary, usclient.RoleSecondary)
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	primaries := 0
+	for _, node := range nodes {
+		if node.Role == usclient.RolePrimary {
+			primaries++
+		}
+	}
+	if primaries > 1 {
+		return nil, errors.New("only one node can be primary")
+	}
+
+	// The first node is the primary unless another is
+	if len(nodes) > 0 && primaries == 0 {
+		nodes[0].Role = usclient.RolePrimary
+	}
+
+	return nodes, nil
+}
+
+// parseCacheTTL converts "action=milliseconds" arguments into cache TTLs
+func parseCacheTTL(list []string) (map[string]int, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	ttl := make(map[string]int)
+	for _, t := range list {
+		kv := strings.SplitN(t, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid cache TTL %q, expecting \"action=milliseconds\"", t)
+		}
+
+		ms, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil || ms < 0 {
+			return nil, fmt.Errorf("invalid milliseconds in cache TTL %q", t)
+		}
+		ttl[strings.TrimSpace(kv[0])] = ms
+	}
+	return ttl, nil
+}
+
+// retryPolicies returns the default retry policies with the given number of retries
+func retryPolicies(retries int) map[string]usclient.RetryPolicy {
+	policies := make(map[string]usclient.RetryPolicy)
+	for action, policy := range usclient.DefaultRetry {
+		policy.Retries = retries
+		policies[action] = policy
+	}
+	return policies
+}
+
+// reloadOnHangup reloads the API keys every time the process receives SIGHUP
+func reloadOnHangup(store *pbserver.KeyStore, logger *log.Logger) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	for range hangup {
+		if err := store.Reload(); err != nil {
+			logger.Errorf("Error reloading API keys, keeping the current ones: %s", err)
+			continue
+		}
+		logger.Infof("Reloaded %d API keys", store.Len())
+	}
+}
+
 func setupLog(debug bool) *log.Logger{
 	l := log.New()
 
@@ -100,9 +215,67 @@ func main() {
 	poolSize := parser.Int("", "poolSize",
 		&argparse.Options{Help: "Unix Socket Pool Size", Default:3})
 
+	poolWait := parser.Int("", "poolwait",
+		&argparse.Options{Help: "Seconds a request waits for an idle unix socket session", Default: 10})
+
+	poolHealth := parser.Int("", "poolhealth",
+		&argparse.Options{Help: "Seconds between health checks of the idle unix socket sessions", Default: 30})
+
+	nodeList := parser.List("", "node",
+		&argparse.Options{Help: "Node as \"URI[,weight[,role]]\", role is primary or secondary, the first node being primary by default. Can be repeated, replaces socket"})
+
+	probeInterval := parser.Int("", "probeinterval",
+		&argparse.Options{Help: "Seconds between health probes of the nodes", Default: 10})
+
+	maxBehind := parser.Int("", "maxbehind",
+		&argparse.Options{Help: "Blocks a node may lag behind the others and still serve reads", Default: 1000})
+
+	retries := parser.Int("", "retries",
+		&argparse.Options{Help: "Retries of the read-only node actions after connection errors, 0 disables", Default: 3})
+
+	breakerFailures := parser.Int("", "breakerfailures",
+		&argparse.Options{Help: "Consecutive failed or slow requests opening the circuit breaker of a node", Default: 5})
+
+	breakerSlow := parser.Int("", "breakerslow",
+		&argparse.Options{Help: "Milliseconds after which a node request counts as failed by the circuit breaker, sends and receives excepted", Default: 5000})
+
+	breakerOpen := parser.Int("", "breakeropen",
+		&argparse.Options{Help: "Seconds an open circuit breaker fails fast before a trial request", Default: 10})
+
+	metrics := parser.String("", "metrics",
+		&argparse.Options{Help: "Address serving the counters on /debug/vars, disabled if missing"})
+
 	socket := parser.String("", "socket",
 		&argparse.Options{Help: "Unix socket path", Default:"local:///tmp/nano"})
 
+	encoding := parser.Selector("", "encoding",
+		[]string{usclient.EncodingJSON, usclient.EncodingFlatbuffers},
+		&argparse.Options{Help: "IPC encoding for typed requests, flatbuffers only covers AccountBalance and falls back to JSON when --cache is set", Default: usclient.EncodingJSON})
+
+	wsURL := parser.String("", "websocket",
+		&argparse.Options{Help: "Node websocket URL", Default: "ws://127.0.0.1:7078"})
+
+	wsCACert := parser.String("", "wscacert",
+		&argparse.Options{Help: "CA cert file for the node websocket"})
+
+	wsCertFile := parser.String("", "wscertfile",
+		&argparse.Options{Help: "Client certificate file for the node websocket"})
+
+	wsKeyFile := parser.String("", "wskeyfile",
+		&argparse.Options{Help: "Client key file for the node websocket"})
+
+	wsInsecure := parser.Flag("", "wsinsecure",
+		&argparse.Options{Help: "Skip node websocket certificate verification (development only)"})
+
+	wsHeaders := parser.List("", "wsheader",
+		&argparse.Options{Help: "Header sent to the node websocket, as \"Name: value\". Can be repeated"})
+
+	wsDialTimeout := parser.Int("", "wsdialtimeout",
+		&argparse.Options{Help: "Node websocket dial timeout in seconds", Default: 45})
+
+	wsProxy := parser.String("", "wsproxy",
+		&argparse.Options{Help: "Proxy URL for the node websocket"})
+
 	ssl := parser.Flag("s", "ssl",
 		&argparse.Options{Help: "Enable ssl", Default: false})
 
@@ -118,12 +291,72 @@ func main() {
 	clientAuth := parser.Flag("", "clientauth",
 		&argparse.Options{Help: "Request client certificate"})
 
+	jwtPubKey := parser.String("", "jwt-pubkey",
+		&argparse.Options{Help: "PEM RSA public key verifying the JWT of the requests, enables authentication"})
+
+	jwtAudience := parser.String("", "jwt-audience",
+		&argparse.Options{Help: "Required aud claim of the JWT"})
+
+	jwtIssuer := parser.String("", "jwt-issuer",
+		&argparse.Options{Help: "Required iss claim of the JWT"})
+
+	jwtAllowNoExp := parser.Flag("", "jwt-allow-no-exp",
+		&argparse.Options{Help: "Accept JWT without exp claim, which never expire"})
+
+	apiKeys := parser.String("", "apikeys",
+		&argparse.Options{Help: "JSON file of hashed API keys, enables authentication. Reloaded on SIGHUP"})
+
 	debug := parser.Flag("D", "debug",
 		&argparse.Options{Help: "Enable detail debug log"})
 
 	localAccounts := parser.Flag("", "localaccounts",
 		&argparse.Options{Help: "Subscribe to confirmation of local accounts only"})
 
+	subBuffer := parser.Int("", "subbuffer",
+		&argparse.Options{Help: "Entries buffered for each subscription", Default: 1000})
+
+	blockTimeout := parser.Int("", "blocktimeout",
+		&argparse.Options{Help: "Milliseconds a BLOCK subscriber may stall the others before being disconnected", Default: 5000})
+
+	journalDir := parser.String("", "journal",
+		&argparse.Options{Help: "Directory of the confirmation journal, enables resumable subscriptions"})
+
+	journalSize := parser.Int("", "journalsize",
+		&argparse.Options{Help: "Confirmations retained in the journal", Default: 100000})
+
+	journalAll := parser.Flag("", "journal-all",
+		&argparse.Options{Help: "Journal the confirmations of every account, not only the subscribed ones, so streams may resume with any accounts"})
+
+	rawAllow := parser.List("", "raw-allow",
+		&argparse.Options{Help: "Node action forwarded by RawRequest, \"*\" for any. Can be repeated, disabled if missing"})
+
+	rawDeny := parser.List("", "raw-deny",
+		&argparse.Options{Help: "Node action refused by RawRequest. Can be repeated, replaces the default list of dangerous actions"})
+
+	blocksBatch := parser.Int("", "blocksbatch",
+		&argparse.Options{Help: "Hashes per blocks_info request of BlocksInfo", Default: 100})
+
+	sendLog := parser.String("", "sendlog",
+		&argparse.Options{Help: "File recording the sends with an id, keeps replays idempotent across restarts"})
+
+	receiveWallet := parser.String("", "receive-wallet",
+		&argparse.Options{Help: "Wallet whose accounts receive automatically the confirmed sends"})
+
+	receiveThreshold := parser.String("", "receive-threshold",
+		&argparse.Options{Help: "Minimum amount in raw received automatically", Default: "1000000000000000000000000"})
+
+	receiveInterval := parser.Int("", "receive-interval",
+		&argparse.Options{Help: "Seconds between sweeps of the receivable blocks", Default: 300})
+
+	cache := parser.Flag("", "cache",
+		&argparse.Options{Help: "Cache the node replies: confirmed blocks, balances until their accounts change, and the slow-changing data"})
+
+	cacheTTL := parser.List("", "cache-ttl",
+		&argparse.Options{Help: "Milliseconds the replies of a node action are cached as \"action=milliseconds\". Can be repeated, replaces the defaults"})
+
+	cacheBlocks := parser.Int("", "cache-blocks",
+		&argparse.Options{Help: "Confirmed blocks kept in the cache", Default: 10000})
+
 	err := parser.Parse(os.Args)
 
 	if err != nil {
@@ -140,6 +373,29 @@ func main() {
 		}
 	}
 
+	headers, err := parseHeaders(*wsHeaders)
+	if err != nil {
+		fmt.Print(parser.Usage(err))
+		os.Exit(1)
+	}
+
+	nodes, err := parseNodes(*nodeList)
+	if err != nil {
+		fmt.Print(parser.Usage(err))
+		os.Exit(1)
+	}
+
+	ttl, err := parseCacheTTL(*cacheTTL)
+	if err != nil {
+		fmt.Print(parser.Usage(err))
+		os.Exit(1)
+	}
+
+	if (*wsCertFile == "") != (*wsKeyFile == "") {
+		fmt.Print(parser.Usage("Need to specify both wscertfile and wskeyfile"))
+		os.Exit(1)
+	}
+
 	logger := setupLog(*debug)
 
 
@@ -149,21 +405,94 @@ func main() {
 	}
 
 	confnode := usclient.ConfNode{
-		Connection: *socket,
-		PoolSize:   *poolSize,
+		Connection:     *socket,
+		PoolSize:       *poolSize,
+		Encoding:       *encoding,
+		WaitTimeout:    *poolWait,
+		HealthInterval: *poolHealth,
+		Nodes:          nodes,
+		ProbeInterval:  *probeInterval,
+		MaxBehind:      uint64(*maxBehind),
+		Retry:          retryPolicies(*retries),
+		Breaker: usclient.ConfBreaker{
+			Failures: *breakerFailures,
+			SlowCall: *breakerSlow,
+			OpenTime: *breakerOpen,
+		},
+	}
+
+	confws := nwsclient.ConfWS{
+		URL:                *wsURL,
+		CACert:             *wsCACert,
+		CertFile:           *wsCertFile,
+		KeyFile:            *wsKeyFile,
+		InsecureSkipVerify: *wsInsecure,
+		Headers:            headers,
+		DialTimeout:        *wsDialTimeout,
+		Proxy:              *wsProxy,
+	}
+
+	server := &pbserver.Server{
+		USConfig: &confnode,
+		WSConfig: &confws,
+		LocalAccounts: *localAccounts,
+		SubscriptionBuffer: *subBuffer,
+		BlockTimeout: *blockTimeout,
+		JournalDir: *journalDir,
+		JournalSize: *journalSize,
+		JournalAll: *journalAll,
+		SendLog: *sendLog,
+		BlocksInfoBatch: *blocksBatch,
+		RawAllow: *rawAllow,
+		Audience: *jwtAudience,
+		Issuer: *jwtIssuer,
+		AllowNoExp: *jwtAllowNoExp,
+	}
+
+	if len(*rawDeny) > 0 {
+		server.RawDeny = *rawDeny
+	}
+
+	if *cache {
+		server.Cache = &pbserver.ConfCache{TTL: ttl, Blocks: *cacheBlocks}
+	}
+
+	if *receiveWallet != "" {
+		server.AutoReceive = &pbserver.ConfReceive{
+			Wallet:    *receiveWallet,
+			Threshold: *receiveThreshold,
+			Interval:  *receiveInterval,
+		}
 	}
 
 	opts := make([]grpc.ServerOption, 0)
 
+	// JWT Authentication
 
-	//opts := []grpc.ServerOption{
-	//	// The following grpc.ServerOption adds an interceptor for all unary
-	//	// RPCs. To configure an interceptor for streaming RPCs, see:
-	//	// https://godoc.org/google.golang.org/grpc#StreamInterceptor
-	//	// grpc.UnaryInterceptor(pbserver.EnsureValidToken),
-	//	// Enable TLS for all incoming connections.
-	//	// grpc.Creds(credentials.NewServerTLSFromCert(&cert)),
-	//}
+	if *jwtPubKey != "" {
+		if err := server.LoadPubKey(*jwtPubKey); err != nil {
+			logger.Fatalf("Error loading JWT public key: %s", err)
+		}
+	}
+
+	// API Keys Authentication
+
+	if *apiKeys != "" {
+		store, err := pbserver.LoadKeyStore(*apiKeys)
+		if err != nil {
+			logger.Fatalf("Error loading API keys: %s", err)
+		}
+		logger.Infof("Loaded %d API keys", store.Len())
+		server.APIKeys = store
+
+		go reloadOnHangup(store, logger)
+	}
+
+	if *jwtPubKey != "" || *apiKeys != "" {
+		opts = append(opts,
+			grpc.UnaryInterceptor(pbserver.EnsureValidToken),
+			grpc.StreamInterceptor(pbserver.EnsureValidTokenStream))
+	}
 
 	// SSL Configuration
 
@@ -197,15 +526,16 @@ func main() {
 	}
 
 
-	s := grpc.NewServer(opts...)
-	server := &pbserver.Server{
-		USConfig: &confnode,
-		LocalAccounts: *localAccounts,
+	if *metrics != "" {
+		go func() {
+			logger.Fatal(http.ListenAndServe(*metrics, nil))
+		}()
 	}
 
-	server.PubKey = nil
+	s := grpc.NewServer(opts...)
 	server.Init(logger)
 	pb.RegisterNanoServer(s, server)
+	pbv2.RegisterNanoServer(s, server.V2())
 	if err := s.Serve(lis); err != nil {
 		logger.Fatalf("failed to serve: %v", err)
 	}
@@ -8,9 +8,11 @@ require (
 	github.com/antonfisher/nested-logrus-formatter v1.0.2
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/golang/protobuf v1.3.2
+	github.com/google/flatbuffers v1.11.0
 	github.com/gorilla/websocket v1.4.1
 	github.com/sirupsen/logrus v1.4.2
 	github.com/stretchr/testify v1.4.0
 	github.com/zput/zxcTool v1.2.8
+	google.golang.org/genproto v0.0.0-20180817151627-c66870c02cf8
 	google.golang.org/grpc v1.24.0
 )
@@ -0,0 +1,293 @@
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"github.com/alvistar/nanopb/internal/recordio"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/proto"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrTruncated is returned by Replay when the requested entries have already
+// been evicted from the journal
+var ErrTruncated = errors.New("sequence no longer retained")
+
+const (
+	segmentSuffix = ".seg"
+	// Number of segments the retained entries are split into
+	segmentCount = 10
+)
+
+type segment struct {
+	first uint64
+	count int
+	path  string
+}
+
+// A Journal is a bounded on-disk ring buffer of subscription entries. Entries
+// are appended to segment files and the oldest segment is removed once the
+// retention size is exceeded.
+type Journal struct {
+	dir            string
+	segmentEntries int
+
+	mutex    sync.Mutex
+	segments []*segment
+	file     *os.File
+	last     uint64
+}
+
+// Open opens or creates a journal in dir retaining at least size entries.
+// Sequence numbers continue from the last entry found on disk.
+func Open(dir string, size int) (*Journal, error) {
+	if size < segmentCount {
+		size = segmentCount
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	j := &Journal{
+		dir:            dir,
+		segmentEntries: size / segmentCount,
+	}
+
+	if err := j.recover(); err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// recover loads the existing segments, truncating a partially written last record
+func (j *Journal) recover() error {
+	files, err := ioutil.ReadDir(j.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), segmentSuffix) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(f.Name(), segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		j.segments = append(j.segments, &segment{first: first, path: filepath.Join(j.dir, f.Name())})
+	}
+
+	sort.Slice(j.segments, func(a, b int) bool {
+		return j.segments[a].first < j.segments[b].first
+	})
+
+	for i, s := range j.segments {
+		count, last, valid, err := scan(s.path)
+		if err != nil {
+			return err
+		}
+		s.count = count
+		if last > j.last {
+			j.last = last
+		}
+
+		if i == len(j.segments)-1 {
+			if err := os.Truncate(s.path, valid); err != nil {
+				return err
+			}
+			if j.file, err = os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0600); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// scan counts the valid records of a segment file, returning the sequence of
+// the last one and the offset following it
+func scan(path string) (count int, last uint64, valid int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	defer f.Close()
+
+	for {
+		data, err := recordio.Read(f)
+		if err != nil {
+			return count, last, valid, nil
+		}
+
+		entry := pb.SubscriptionEntry{}
+		if err := proto.Unmarshal(data, &entry); err != nil {
+			return count, last, valid, nil
+		}
+
+		count++
+		last = entry.Sequence
+		valid += int64(recordio.HeaderSize + len(data))
+	}
+}
+
+// rotate starts a new segment whose first entry is first, evicting the oldest
+// segments beyond the retention size. Must be called with mutex held.
+func (j *Journal) rotate(first uint64) error {
+	if j.file != nil {
+		if err := j.file.Close(); err != nil {
+			return err
+		}
+		j.file = nil
+	}
+
+	path := filepath.Join(j.dir, fmt.Sprintf("%020d%s", first, segmentSuffix))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	j.file = f
+	j.segments = append(j.segments, &segment{first: first, path: path})
+
+	for len(j.segments) > segmentCount+1 {
+		if err := os.Remove(j.segments[0].path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		j.segments = j.segments[1:]
+	}
+
+	return nil
+}
+
+// Append stamps entry with the next sequence number and stores it. The
+// sequence number is consumed even if the entry cannot be written.
+func (j *Journal) Append(entry *pb.SubscriptionEntry) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.last++
+	entry.Sequence = j.last
+
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	buf, err := recordio.Frame(data)
+	if err != nil {
+		return err
+	}
+
+	n := len(j.segments)
+	if j.file == nil || n == 0 || j.segments[n-1].count >= j.segmentEntries {
+		if err := j.rotate(entry.Sequence); err != nil {
+			return err
+		}
+	}
+
+	if _, err := j.file.Write(buf); err != nil {
+		// Leave the partial record at the end of its segment
+		_ = j.file.Close()
+		j.file = nil
+		return err
+	}
+
+	j.segments[len(j.segments)-1].count++
+	return nil
+}
+
+// Last returns the sequence number of the latest entry, 0 if the journal is empty
+func (j *Journal) Last() uint64 {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.last
+}
+
+// Replay calls fn for every retained entry with a sequence greater than from,
+// up to the last entry at the time of the call. It returns the sequence of the
+// last entry passed to fn, or from if there was none. ErrTruncated is returned
+// if some of the entries following from have already been evicted.
+func (j *Journal) Replay(from uint64, fn func(entry *pb.SubscriptionEntry) error) (uint64, error) {
+	j.mutex.Lock()
+
+	until := j.last
+	if from >= until {
+		j.mutex.Unlock()
+		return from, nil
+	}
+
+	if len(j.segments) == 0 || j.segments[0].first > from+1 {
+		j.mutex.Unlock()
+		return from, ErrTruncated
+	}
+
+	// Open the files while locked, so they stay readable if evicted meanwhile
+	files := make([]*os.File, 0)
+	for i, s := range j.segments {
+		if i+1 < len(j.segments) && j.segments[i+1].first <= from+1 {
+			continue
+		}
+		f, err := os.Open(s.path)
+		if err != nil {
+			j.mutex.Unlock()
+			closeAll(files)
+			return from, err
+		}
+		files = append(files, f)
+	}
+	j.mutex.Unlock()
+	defer closeAll(files)
+
+	last := from
+	for _, f := range files {
+		for last < until {
+			data, err := recordio.Read(f)
+			if err != nil {
+				break
+			}
+
+			entry := pb.SubscriptionEntry{}
+			if err := proto.Unmarshal(data, &entry); err != nil {
+				return last, err
+			}
+
+			if entry.Sequence <= from {
+				continue
+			}
+
+			if err := fn(&entry); err != nil {
+				return last, err
+			}
+			last = entry.Sequence
+		}
+	}
+
+	return last, nil
+}
+
+func closeAll(files []*os.File) {
+	for _, f := range files {
+		_ = f.Close()
+	}
+}
+
+// Close closes the current segment file
+func (j *Journal) Close() error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
@@ -0,0 +1,127 @@
+package journal
+
+import (
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "journal")
+	require.Nil(t, err)
+	return dir
+}
+
+func appendN(t *testing.T, j *Journal, n int) {
+	for i := 0; i < n; i++ {
+		entry := pb.SubscriptionEntry{Topic: "confirmation", Time: strconv.Itoa(i)}
+		require.Nil(t, j.Append(&entry))
+	}
+}
+
+func replayAll(t *testing.T, j *Journal, from uint64) ([]uint64, error) {
+	seqs := make([]uint64, 0)
+	_, err := j.Replay(from, func(entry *pb.SubscriptionEntry) error {
+		seqs = append(seqs, entry.Sequence)
+		return nil
+	})
+	return seqs, err
+}
+
+func TestAppendReplay(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	j, err := Open(dir, 100)
+	require.Nil(t, err)
+	defer j.Close()
+
+	appendN(t, j, 25)
+	assert.Equal(t, uint64(25), j.Last())
+
+	seqs, err := replayAll(t, j, 20)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{21, 22, 23, 24, 25}, seqs)
+
+	last, err := j.Replay(25, func(entry *pb.SubscriptionEntry) error {
+		assert.Fail(t, "nothing to replay")
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(25), last)
+}
+
+func TestReopenContinuesSequence(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	j, err := Open(dir, 100)
+	require.Nil(t, err)
+	appendN(t, j, 15)
+	require.Nil(t, j.Close())
+
+	j, err = Open(dir, 100)
+	require.Nil(t, err)
+	defer j.Close()
+	assert.Equal(t, uint64(15), j.Last())
+
+	appendN(t, j, 1)
+	seqs, err := replayAll(t, j, 13)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{14, 15, 16}, seqs)
+}
+
+func TestEviction(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	// 10 entries per segment, 11 segments kept
+	j, err := Open(dir, 100)
+	require.Nil(t, err)
+	defer j.Close()
+
+	appendN(t, j, 200)
+
+	_, err = replayAll(t, j, 50)
+	assert.Equal(t, ErrTruncated, err)
+
+	seqs, err := replayAll(t, j, 100)
+	require.Nil(t, err)
+	assert.Equal(t, 100, len(seqs))
+	assert.Equal(t, uint64(101), seqs[0])
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	assert.Equal(t, segmentCount+1, len(files))
+}
+
+func TestRecoverTornRecord(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	j, err := Open(dir, 100)
+	require.Nil(t, err)
+	appendN(t, j, 3)
+	require.Nil(t, j.Close())
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	require.Equal(t, 1, len(files))
+	f, err := os.OpenFile(files[0], os.O_WRONLY|os.O_APPEND, 0600)
+	require.Nil(t, err)
+	_, _ = f.Write([]byte{0, 0, 0, 50, 1, 2})
+	require.Nil(t, f.Close())
+
+	j, err = Open(dir, 100)
+	require.Nil(t, err)
+	defer j.Close()
+	assert.Equal(t, uint64(3), j.Last())
+
+	appendN(t, j, 1)
+	seqs, err := replayAll(t, j, 1)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{2, 3, 4}, seqs)
+}
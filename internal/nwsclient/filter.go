@@ -0,0 +1,60 @@
+package nwsclient
+
+import (
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"math/big"
+)
+
+// Subtypes of confirmed blocks which can be used in a Filter
+var Subtypes = []string{"send", "receive", "change", "epoch"}
+
+// A Filter selects the confirmations delivered to a subscription
+type Filter struct {
+	// Accounts to match, all accounts if empty
+	Accounts []string
+	// Side of the block matched against Accounts
+	Match pb.AccountMatch
+	// Block subtypes to deliver, all subtypes if empty
+	Subtypes []string
+	// Minimum amount in raw, no minimum if nil
+	MinAmount *big.Int
+}
+
+// matchAccount reports whether the block side selected by Match is in Accounts
+func (f *Filter) matchAccount(entry *pb.SubscriptionEntry) bool {
+	if len(f.Accounts) == 0 {
+		return true
+	}
+
+	sender := entry.GetMessage().GetAccount()
+	recipient := entry.GetMessage().GetBlock().GetLinkAsAccount()
+
+	switch f.Match {
+	case pb.AccountMatch_SENDER:
+		return stringInSlice(sender, f.Accounts)
+	case pb.AccountMatch_EITHER:
+		return stringInSlice(sender, f.Accounts) || stringInSlice(recipient, f.Accounts)
+	default:
+		return stringInSlice(recipient, f.Accounts)
+	}
+}
+
+// matchAmount reports whether the confirmed amount is at least MinAmount
+func (f *Filter) matchAmount(entry *pb.SubscriptionEntry) bool {
+	if f.MinAmount == nil {
+		return true
+	}
+
+	amount, ok := new(big.Int).SetString(entry.GetMessage().GetAmount(), 10)
+	return ok && amount.Cmp(f.MinAmount) >= 0
+}
+
+// Matches reports whether entry passes every condition of the filter
+func (f *Filter) Matches(entry *pb.SubscriptionEntry) bool {
+	if len(f.Subtypes) > 0 &&
+		!stringInSlice(entry.GetMessage().GetBlock().GetSubtype(), f.Subtypes) {
+		return false
+	}
+
+	return f.matchAccount(entry) && f.matchAmount(entry)
+}
@@ -0,0 +1,55 @@
+package nwsclient
+
+import (
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+const (
+	sender    = "nano_1tgkjkq9r96zd3pkr7edj8e4qbu3wr3ps6ettzse8hmoa37nurua7faupjhc"
+	recipient = "nano_1e6rym1f5p7xj4fh1y8fzy1ym1orxymffp9tx7cey58whakprhwdzuk533th"
+)
+
+func sendEntry(t *testing.T) *pb.SubscriptionEntry {
+	entry := pb.SubscriptionEntry{}
+	require.Nil(t, jsonpb.UnmarshalString(MESSAGE, &entry))
+	return &entry
+}
+
+func TestFilterMatch(t *testing.T) {
+	entry := sendEntry(t)
+
+	assert.True(t, (&Filter{}).Matches(entry))
+	assert.True(t, (&Filter{Accounts: []string{recipient}}).Matches(entry))
+	assert.False(t, (&Filter{Accounts: []string{sender}}).Matches(entry))
+	assert.True(t, (&Filter{Accounts: []string{sender}, Match: pb.AccountMatch_SENDER}).Matches(entry))
+	assert.False(t, (&Filter{Accounts: []string{recipient}, Match: pb.AccountMatch_SENDER}).Matches(entry))
+	assert.True(t, (&Filter{Accounts: []string{sender}, Match: pb.AccountMatch_EITHER}).Matches(entry))
+	assert.True(t, (&Filter{Accounts: []string{recipient}, Match: pb.AccountMatch_EITHER}).Matches(entry))
+}
+
+func TestFilterSubtypes(t *testing.T) {
+	entry := sendEntry(t)
+
+	assert.True(t, (&Filter{Subtypes: []string{"send", "receive"}}).Matches(entry))
+	assert.False(t, (&Filter{Subtypes: []string{"change"}}).Matches(entry))
+}
+
+func TestFilterMinAmount(t *testing.T) {
+	entry := sendEntry(t)
+
+	min, _ := new(big.Int).SetString("15621963968634827029081574961", 10)
+	assert.True(t, (&Filter{MinAmount: min}).Matches(entry))
+
+	min.Add(min, big.NewInt(1))
+	assert.False(t, (&Filter{MinAmount: min}).Matches(entry))
+}
+
+func TestFilterStatusEntry(t *testing.T) {
+	entry := pb.SubscriptionEntry{Topic: "status"}
+	assert.False(t, (&Filter{Accounts: []string{sender}, Match: pb.AccountMatch_EITHER}).Matches(&entry))
+}
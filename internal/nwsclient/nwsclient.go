@@ -1,16 +1,60 @@
 package nwsclient
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
+	"fmt"
 	pb "github.com/alvistar/nanopb/nanoproto"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/gorilla/websocket"
 	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// ConfWS configures the connection to the node websocket
+type ConfWS struct {
+	// ws:// or wss:// URL of the node websocket
+	URL string `json:"url"`
+	// PEM file with the CA certificates used to verify the server
+	CACert string `json:"cacert"`
+	// Client certificate and key files, for servers requiring client authentication
+	CertFile string `json:"certfile"`
+	KeyFile  string `json:"keyfile"`
+	// Skip server certificate verification. For development only.
+	InsecureSkipVerify bool `json:"insecureskipverify"`
+	// Additional headers sent with the handshake request
+	Headers map[string]string `json:"headers"`
+	// Handshake timeout in seconds. Default is 45 seconds.
+	DialTimeout int `json:"dialtimeout"`
+	// Proxy URL. When empty, the proxy is taken from the environment.
+	Proxy string `json:"proxy"`
+}
+
+const defaultURL = "ws://127.0.0.1:7078"
+
+// Default reconnection backoff bounds
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// The node adds fields to its messages over time, they must not break the streams
+var unmarshaler = jsonpb.Unmarshaler{AllowUnknownFields: true}
+
+// Default wait for a subscriber under the BLOCK policy
+const defaultBlockTimeout = 5 * time.Second
+
 func stringInSlice(a string, list []string) bool {
 	for _, b := range list {
 		if b == a {
@@ -20,16 +64,180 @@ func stringInSlice(a string, list []string) bool {
 	return false
 }
 
+// outbox applies an OverflowPolicy to the deliveries of a subscription
+type outbox struct {
+	policy       pb.OverflowPolicy
+	blockTimeout time.Duration
+	dropped    uint64
+	done       chan struct{}
+	doneOnce   sync.Once
+	overflowed chan struct{}
+	overOnce   sync.Once
+}
+
+func newOutbox(policy pb.OverflowPolicy, blockTimeout time.Duration) outbox {
+	if blockTimeout <= 0 {
+		blockTimeout = defaultBlockTimeout
+	}
+
+	return outbox{
+		policy:       policy,
+		blockTimeout: blockTimeout,
+		done:       make(chan struct{}),
+		overflowed: make(chan struct{}),
+	}
+}
+
+// Dropped returns the number of entries discarded so far
+func (o *outbox) Dropped() uint64 {
+	return atomic.LoadUint64(&o.dropped)
+}
+
+// Overflowed is closed when the buffer fills up under the DISCONNECT policy,
+// or when the subscriber stalls longer than the block timeout under BLOCK
+func (o *outbox) Overflowed() <-chan struct{} {
+	return o.overflowed
+}
+
+func (o *outbox) close() {
+	o.doneOnce.Do(func() {
+		close(o.done)
+	})
+}
+
+func (o *outbox) overflow() {
+	atomic.AddUint64(&o.dropped, 1)
+	o.overOnce.Do(func() {
+		close(o.overflowed)
+	})
+}
+
+// deliver applies the policy on top of the channel operations of the
+// subscription: trySend sends without blocking, send blocks until sent, done
+// is closed or timeout fires and reports whether it did not time out,
+// dropOldest discards the oldest buffered entry if any.
+func (o *outbox) deliver(trySend func() bool, send func(done <-chan struct{}, timeout <-chan time.Time) bool,
+	dropOldest func() bool) {
+	select {
+	case <-o.done:
+		return
+	case <-o.overflowed:
+		return
+	default:
+	}
+
+	switch o.policy {
+	case pb.OverflowPolicy_BLOCK:
+		// A stalled subscriber holds up the websocket reader, and with it every
+		// other subscription: past the timeout it is disconnected instead
+		timer := time.NewTimer(o.blockTimeout)
+		defer timer.Stop()
+
+		if !send(o.done, timer.C) {
+			o.overflow()
+		}
+
+	case pb.OverflowPolicy_DROP_OLDEST:
+		for !trySend() {
+			if dropOldest() {
+				atomic.AddUint64(&o.dropped, 1)
+			}
+		}
+
+	default:
+		if !trySend() {
+			o.overflow()
+		}
+	}
+}
+
 type Subscription struct {
-	channel  *chan pb.SubscriptionEntry
-	accounts []string
+	outbox
+	channel *chan pb.SubscriptionEntry
+	// Guards filter, which SetFilter may replace
+	mutex  sync.Mutex
+	filter Filter
+}
+
+func (s *Subscription) currentFilter() Filter {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.filter
+}
+
+// deliver sends entry to the subscriber channel applying the overflow policy
+func (s *Subscription) deliver(entry pb.SubscriptionEntry) {
+	s.outbox.deliver(
+		func() bool {
+			entry.Dropped = s.Dropped()
+			select {
+			case *s.channel <- entry:
+				return true
+			default:
+				return false
+			}
+		},
+		func(done <-chan struct{}, timeout <-chan time.Time) bool {
+			entry.Dropped = s.Dropped()
+			select {
+			case *s.channel <- entry:
+			case <-done:
+			case <-timeout:
+				return false
+			}
+			return true
+		},
+		func() bool {
+			select {
+			case <-*s.channel:
+				return true
+			default:
+				return false
+			}
+		})
+}
+
+// A Journal records confirmations before they are delivered, stamping them
+// with their sequence number
+type Journal interface {
+	Append(entry *pb.SubscriptionEntry) error
 }
 
 type WSClient struct {
 	Done          chan struct{}
 	LocalAccounts bool
+	// Optional journal of delivered confirmations. Without a journal, sequence
+	// numbers restart from 1 with the process.
+	Journal Journal
+	// Journal the confirmations of every account, so that a stream may resume
+	// with any accounts, at the cost of the account filter of the node.
+	// Otherwise the journal only holds the accounts subscribed at the time,
+	// and a stream resuming with accounts nobody was subscribed to misses
+	// their confirmations.
+	JournalAll bool
+	sequence   uint64
+	// Reconnection backoff bounds. Defaults are 500ms and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// How long a delivery waits for a subscriber under the BLOCK policy before
+	// disconnecting it. Default is 5s.
+	BlockTimeout time.Duration
+	conf       *ConfWS
+	dialer     *websocket.Dialer
+	header     http.Header
+	// Guards conn replacement and writes; reads only happen in wsprocess
+	connMutex     sync.Mutex
 	conn          *websocket.Conn
+	connected     int32
+	// Guards the account filter and the topics known by the node
+	filterMutex  sync.Mutex
+	filtered     bool
+	nodeAccounts map[string]bool
+	nodeTopics   map[string]bool
+	closing       chan struct{}
+	closeOnce     sync.Once
 	subscriptions sync.Map
+	topicSubscriptions sync.Map
 	logger        *log.Entry
 }
 
@@ -37,122 +245,503 @@ func (client *WSClient) wsprocess() {
 	client.logger.Debug("Starting wsprocess")
 	defer close(client.Done)
 	for {
-		_, message, err := client.conn.ReadMessage()
+		conn := client.connection()
+		if conn == nil {
+			if !client.reconnect() {
+				return
+			}
+			continue
+		}
+
+		_, message, err := conn.ReadMessage()
 		if err != nil {
-			//if websocket.IsCloseError(err, 1000) {
-			//	client.logger.Error("closing error")
-			//	return
-			//}
+			if client.isClosing() {
+				return
+			}
 			client.logger.Error("read:", err)
-			client.logger.Error("connection lost - terminating")
-			panic("connection lost with websocket")
+			client.logger.Warn("connection lost - reconnecting")
+			if !client.reconnect() {
+				return
+			}
+			continue
 		}
 
 		client.subHandler(string(message))
 	}
 }
 
+// newDialer builds the websocket dialer and handshake headers for conf
+func newDialer(conf *ConfWS) (*websocket.Dialer, http.Header, error) {
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 45 * time.Second,
+	}
+
+	if conf.DialTimeout > 0 {
+		dialer.HandshakeTimeout = time.Duration(conf.DialTimeout) * time.Second
+	}
+
+	if conf.Proxy != "" {
+		proxy, err := url.Parse(conf.Proxy)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid proxy: %s", err)
+		}
+		dialer.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := tls.Config{InsecureSkipVerify: conf.InsecureSkipVerify}
+
+	if conf.CACert != "" {
+		pem, err := ioutil.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read CA certificate: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, nil, errors.New("failed to append CA certs")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	dialer.TLSClientConfig = &tlsConfig
+
+	header := http.Header{}
+	for k, v := range conf.Headers {
+		header.Set(k, v)
+	}
+
+	return &dialer, header, nil
+}
+
+func (client *WSClient) connection() *websocket.Conn {
+	client.connMutex.Lock()
+	defer client.connMutex.Unlock()
+	return client.conn
+}
+
+// Connected reports whether the websocket is currently connected to the node
+func (client *WSClient) Connected() bool {
+	return atomic.LoadInt32(&client.connected) == 1
+}
+
+func (client *WSClient) isClosing() bool {
+	select {
+	case <-client.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// accountFilter returns the union of the accounts of the active subscriptions.
+// all is true when at least one subscription wants every account, or when the
+// journal records them all.
+func (client *WSClient) accountFilter() (accounts map[string]bool, all bool) {
+	accounts = make(map[string]bool)
+
+	if client.Journal != nil && client.JournalAll {
+		return accounts, true
+	}
+
+	client.subscriptions.Range(
+		func(key, value interface{}) bool {
+			filter := value.(*Subscription).currentFilter()
+
+			if len(filter.Accounts) == 0 {
+				all = true
+				return false
+			}
+
+			for _, account := range filter.Accounts {
+				accounts[account] = true
+			}
+			return true
+		})
+
+	return accounts, all
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// subscribeRequest returns the subscribe action for the current options and
+// records the account filter sent to the node. Must be called with filterMutex held.
+func (client *WSClient) subscribeRequest() map[string]interface{} {
+	request := map[string]interface{}{
+		"action": "subscribe",
+		"topic":  "confirmation",
+		"ack":    "false",
+	}
+
+	if client.LocalAccounts {
+		request["options"] = map[string]interface{}{
+			"all_local_accounts": true,
+		}
+		return request
+	}
+
+	accounts, all := client.accountFilter()
+	client.filtered = !all
+	client.nodeAccounts = accounts
+
+	if client.filtered {
+		request["options"] = map[string]interface{}{
+			"accounts": sortedKeys(accounts),
+		}
+	}
+
+	return request
+}
+
+// updateFilter pushes changes in the subscribed accounts to the node, so that
+// it only sends relevant confirmations. While disconnected nothing is sent, as
+// the next subscribe request carries the whole filter.
+func (client *WSClient) updateFilter() {
+	if client.LocalAccounts {
+		return
+	}
+
+	client.filterMutex.Lock()
+	defer client.filterMutex.Unlock()
+
+	if !client.Connected() {
+		return
+	}
+
+	accounts, all := client.accountFilter()
+
+	if all == client.filtered {
+		// Switching between filtered and unfiltered requires a new subscription
+		request := client.subscribeRequest()
+		client.logger.Debug("Request: ", request)
+		if err := client.write(request); err != nil {
+			client.logger.Error("subscribing:", err)
+		}
+		return
+	}
+
+	if all {
+		return
+	}
+
+	add := make([]string, 0)
+	for account := range accounts {
+		if !client.nodeAccounts[account] {
+			add = append(add, account)
+		}
+	}
+
+	del := make([]string, 0)
+	for account := range client.nodeAccounts {
+		if !accounts[account] {
+			del = append(del, account)
+		}
+	}
+
+	if len(add) == 0 && len(del) == 0 {
+		return
+	}
+
+	sort.Strings(add)
+	sort.Strings(del)
+
+	options := map[string]interface{}{}
+	if len(add) > 0 {
+		options["accounts_add"] = add
+	}
+	if len(del) > 0 {
+		options["accounts_del"] = del
+	}
+
+	request := map[string]interface{}{
+		"action":  "update",
+		"topic":   "confirmation",
+		"options": options,
+	}
+
+	client.logger.Debug("Request: ", request)
+
+	if err := client.write(request); err != nil {
+		// The filter is sent again on reconnection
+		client.logger.Error("updating filter:", err)
+		return
+	}
+
+	client.nodeAccounts = accounts
+}
+
+// write sends a JSON message on the current connection
+func (client *WSClient) write(request interface{}) error {
+	data, _ := json.Marshal(request)
+
+	client.connMutex.Lock()
+	defer client.connMutex.Unlock()
+
+	if client.conn == nil {
+		return websocket.ErrCloseSent
+	}
+	return client.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// connect dials the node and subscribes with the current options
+func (client *WSClient) connect() error {
+	client.logger.Info("connecting to ", client.conf.URL)
+
+	conn, _, err := client.dialer.Dial(client.conf.URL, client.header)
+	if err != nil {
+		return err
+	}
+
+	client.connMutex.Lock()
+	client.conn = conn
+	client.connMutex.Unlock()
+
+	// Hold the filter lock until connected, so that no update is missed
+	client.filterMutex.Lock()
+	defer client.filterMutex.Unlock()
+
+	request := client.subscribeRequest()
+	client.logger.Info("Request: ", request)
+
+	if err := client.write(request); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	// A new connection starts without any topic
+	client.nodeTopics = make(map[string]bool)
+	if err := client.syncTopics(); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	client.logger.Info("connected")
+	atomic.StoreInt32(&client.connected, 1)
+	return nil
+}
+
+// reconnect dials again with exponential backoff and jitter until it succeeds.
+// Returns false if the client has been closed in the meantime.
+func (client *WSClient) reconnect() bool {
+	atomic.StoreInt32(&client.connected, 0)
+	client.notify(pb.ConnectionStatus_RECONNECTING)
+
+	client.connMutex.Lock()
+	if client.conn != nil {
+		_ = client.conn.Close()
+		client.conn = nil
+	}
+	client.connMutex.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-client.closing:
+			return false
+		case <-time.After(client.backoff(attempt)):
+		}
+
+		if err := client.connect(); err != nil {
+			client.logger.Error("dial:", err)
+			continue
+		}
+
+		client.notify(pb.ConnectionStatus_CONNECTED)
+		return true
+	}
+}
+
+// backoff returns the delay before the given reconnection attempt, picked at
+// random in the upper half of the exponential window
+func (client *WSClient) backoff(attempt int) time.Duration {
+	min, max := client.MinBackoff, client.MaxBackoff
+	if min <= 0 {
+		min = defaultMinBackoff
+	}
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+
+	d := min
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// notify sends a connection status entry to every subscriber
+func (client *WSClient) notify(status pb.ConnectionStatus) {
+	entry := pb.SubscriptionEntry{
+		Topic:  "status",
+		Time:   strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10),
+		Status: status,
+	}
+
+	client.subscriptions.Range(
+		func(key, value interface{}) bool {
+			value.(*Subscription).deliver(entry)
+			return true
+		})
+}
+
 func (client *WSClient) subHandler(message string) {
+	if topic := messageTopic(message); topicEntries[topic] != nil {
+		client.topicHandler(topic, message)
+		return
+	}
+
 	entry := pb.SubscriptionEntry{}
 
-	if err := jsonpb.UnmarshalString(message, &entry); err != nil {
+	if err := unmarshaler.Unmarshal(strings.NewReader(message), &entry); err != nil {
 		client.logger.Error("error unmarshaling message: ", err.Error())
 		return
 	}
 
 	client.logger.Debugln("received:", entry)
 
+	if client.Journal != nil {
+		if err := client.Journal.Append(&entry); err != nil {
+			client.logger.Error("error writing journal: ", err)
+		}
+	} else {
+		entry.Sequence = atomic.AddUint64(&client.sequence, 1)
+	}
+
 	client.subscriptions.Range(
 		func(key, value interface{}) bool {
 			subscription := value.(*Subscription)
 
-			if len(subscription.accounts) == 0 ||
-				stringInSlice(entry.Message.Block.LinkAsAccount, subscription.accounts) {
-
-				select {
-				case *subscription.channel <- entry:
-				default:
-				}
+			if filter := subscription.currentFilter(); filter.Matches(&entry) {
+				subscription.deliver(entry)
 			}
 			return true
 		})
 }
 
+// Subscribe delivers to channel the confirmations sent to the given accounts,
+// or all confirmations if accounts is empty. Delivery waits for the channel up
+// to BlockTimeout, then the subscription stops.
 func (client *WSClient) Subscribe(channel *chan pb.SubscriptionEntry, account []string) {
+	_, _ = client.SubscribeFilter(channel, Filter{Accounts: account}, pb.OverflowPolicy_BLOCK)
+}
+
+// SubscribeFilter delivers to channel the confirmations matching filter. The
+// capacity of channel is the subscription buffer, and policy tells what to do
+// when it is full. DROP_OLDEST requires a buffered channel.
+func (client *WSClient) SubscribeFilter(channel *chan pb.SubscriptionEntry, filter Filter,
+	policy pb.OverflowPolicy) (*Subscription, error) {
+	if policy == pb.OverflowPolicy_DROP_OLDEST && cap(*channel) == 0 {
+		return nil, errors.New("DROP_OLDEST requires a buffered channel")
+	}
+
 	s := Subscription{
-		channel:  channel,
-		accounts: account,
+		outbox:  newOutbox(policy, client.BlockTimeout),
+		channel: channel,
+		filter:  filter,
 	}
 
 	client.subscriptions.Store(channel, &s)
+	client.updateFilter()
+	return &s, nil
+}
+
+// SetFilter replaces the filter of the subscription of channel, keeping its
+// buffered entries and drop count
+func (client *WSClient) SetFilter(channel *chan pb.SubscriptionEntry, filter Filter) {
+	value, ok := client.subscriptions.Load(channel)
+	if !ok {
+		return
+	}
+
+	subscription := value.(*Subscription)
+	subscription.mutex.Lock()
+	subscription.filter = filter
+	subscription.mutex.Unlock()
 
+	client.updateFilter()
 }
 
 func (client *WSClient) Unsubscribe(channel *chan pb.SubscriptionEntry) {
+	if value, ok := client.subscriptions.Load(channel); ok {
+		value.(*Subscription).close()
+	}
 	client.subscriptions.Delete(channel)
+	client.updateFilter()
 }
 
 func (client *WSClient) Close() {
 	client.logger.Info("Closing connection")
-	err := client.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	client.closeOnce.Do(func() {
+		close(client.closing)
+	})
+
+	conn := client.connection()
+	if conn == nil {
+		return
+	}
+
+	client.connMutex.Lock()
+	err := conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	client.connMutex.Unlock()
 	if err != nil {
 		client.logger.Error("write close:", err)
-		return
 	}
+
 	select {
 	case <-client.Done:
 	case <-time.After(time.Second):
 	}
 
-	_ = client.conn.Close()
+	_ = conn.Close()
 }
 
-func (client *WSClient) Init(l *log.Logger) {
+// Init starts the websocket process. If the node is not reachable yet, the
+// client keeps retrying in the background.
+func (client *WSClient) Init(conf *ConfWS, l *log.Logger) {
 	var err error
 
 	client.Done = make(chan struct{})
-
-	u := url.URL{Scheme: "ws", Host: "127.0.0.1:7078", Path: ""}
+	client.closing = make(chan struct{})
 
 	if l == nil {
 		l = log.New()
 	}
 
 	client.logger = l.WithFields(log.Fields{"component": "nwsclient"})
-	client.logger.Info("connecting to ", u.String())
 
-	client.conn, _, err = websocket.DefaultDialer.Dial(u.String(), nil)
-	if err != nil {
-		client.logger.Fatal("dial:", err)
+	if conf == nil {
+		client.conf = &ConfWS{URL: defaultURL}
+	} else {
+		client.conf = conf
 	}
 
-	client.logger.Info("connected")
-
-	// accounts := []string{"nano_1jrd1ri7dfo1gyh9iqqmtfk1aq64oi9c57xixtjdosfjwmxpkebpuruuen34"}
-
-	request := map[string]interface{}{
-		"action": "subscribe",
-		"topic":  "confirmation",
-		"ack":    "false",
-		//"options": map[string]interface{} {
-		//	"accounts": accounts,
-		//},
+	if client.conf.URL == "" {
+		client.conf.URL = defaultURL
 	}
 
-	if client.LocalAccounts {
-		request["options"]= map[string]interface{} {
-			"all_local_accounts": true,
-		}
+	if client.dialer, client.header, err = newDialer(client.conf); err != nil {
+		client.logger.Fatal("websocket configuration: ", err)
 	}
 
-	data, _ := json.Marshal(request)
-
-	client.logger.Info("Request: ", string(data))
-
-	err = client.conn.WriteMessage(websocket.TextMessage, data)
-
-	if err != nil {
-		log.Fatal("subscribing:", err)
+	if err := client.connect(); err != nil {
+		client.logger.Error("dial:", err)
 	}
 
 	go client.wsprocess()
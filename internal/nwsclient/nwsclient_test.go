@@ -2,7 +2,14 @@ package nwsclient
 
 import (
 	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -39,7 +46,7 @@ var MESSAGE = `
 `
 
 func TestSubscription(t *testing.T) {
-	client := WSClient{}
+	client := WSClient{logger: log.NewEntry(log.New())}
 
 	mychan := make(chan pb.SubscriptionEntry)
 
@@ -56,7 +63,7 @@ func TestSubscription(t *testing.T) {
 }
 
 func TestSubscriptionAll(t *testing.T) {
-	client := WSClient{}
+	client := WSClient{logger: log.NewEntry(log.New())}
 
 	mychan := make(chan pb.SubscriptionEntry)
 
@@ -70,4 +77,438 @@ func TestSubscriptionAll(t *testing.T) {
 	case <-time.After(3 * time.Second):
 		assert.Fail(t, "Timeout")
 	}
-}
\ No newline at end of file
+}
+
+// fakeNode is a websocket server which drops the first connection right
+// after the subscription and streams MESSAGE on the following ones.
+type fakeNode struct {
+	server     *httptest.Server
+	subscribes int32
+}
+
+func newFakeNode() *fakeNode {
+	node := &fakeNode{}
+	upgrader := websocket.Upgrader{}
+
+	node.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if _, msg, err := conn.ReadMessage(); err != nil || !strings.Contains(string(msg), "subscribe") {
+			return
+		}
+
+		if atomic.AddInt32(&node.subscribes, 1) == 1 {
+			return
+		}
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(MESSAGE))
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+
+	return node
+}
+
+func (node *fakeNode) url() string {
+	return "ws" + strings.TrimPrefix(node.server.URL, "http")
+}
+
+func receive(t *testing.T, ch chan pb.SubscriptionEntry) pb.SubscriptionEntry {
+	select {
+	case entry := <-ch:
+		return entry
+	case <-time.After(3 * time.Second):
+		require.Fail(t, "Timeout")
+	}
+	return pb.SubscriptionEntry{}
+}
+
+func TestReconnect(t *testing.T) {
+	node := newFakeNode()
+	defer node.server.Close()
+
+	client := WSClient{MinBackoff: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond}
+
+	mychan := make(chan pb.SubscriptionEntry, 10)
+	client.Subscribe(&mychan, []string{})
+
+	client.Init(&ConfWS{URL: node.url()}, nil)
+	defer client.Close()
+
+	entry := receive(t, mychan)
+	assert.Equal(t, "status", entry.Topic)
+	assert.Equal(t, pb.ConnectionStatus_RECONNECTING, entry.Status)
+
+	entry = receive(t, mychan)
+	assert.Equal(t, "status", entry.Topic)
+	assert.Equal(t, pb.ConnectionStatus_CONNECTED, entry.Status)
+
+	entry = receive(t, mychan)
+	assert.Equal(t, "c950fc037d61e372", entry.Message.Block.Work)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&node.subscribes))
+	assert.True(t, client.Connected())
+}
+
+func TestBackoff(t *testing.T) {
+	client := WSClient{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, window := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		d := client.backoff(attempt)
+		window *= time.Millisecond
+		assert.True(t, d >= window/2 && d <= window, "attempt %d: %s", attempt, d)
+	}
+}
+
+func TestTLSWithHeaders(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	headers := make(chan string, 1)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get("Authorization")
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := WSClient{}
+	client.Init(&ConfWS{
+		URL:                "wss" + strings.TrimPrefix(server.URL, "https"),
+		InsecureSkipVerify: true,
+		Headers:            map[string]string{"Authorization": "Bearer secret"},
+		DialTimeout:        1,
+	}, nil)
+	defer client.Close()
+
+	assert.Equal(t, "Bearer secret", <-headers)
+	assert.True(t, client.Connected())
+}
+
+func TestDialerInvalidProxy(t *testing.T) {
+	_, _, err := newDialer(&ConfWS{Proxy: "://bad"})
+	assert.Error(t, err)
+}
+
+// recordingNode is a websocket server forwarding every received message to requests
+func recordingNode(requests chan map[string]interface{}) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			request := map[string]interface{}{}
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			requests <- request
+		}
+	}))
+}
+
+func nextRequest(t *testing.T, requests chan map[string]interface{}) map[string]interface{} {
+	select {
+	case request := <-requests:
+		return request
+	case <-time.After(3 * time.Second):
+		require.Fail(t, "Timeout")
+	}
+	return nil
+}
+
+func TestAccountFilterUpdates(t *testing.T) {
+	requests := make(chan map[string]interface{}, 10)
+	server := recordingNode(requests)
+	defer server.Close()
+
+	client := WSClient{}
+	client.Init(&ConfWS{URL: "ws" + strings.TrimPrefix(server.URL, "http")}, nil)
+	defer client.Close()
+
+	request := nextRequest(t, requests)
+	assert.Equal(t, "subscribe", request["action"])
+	assert.Equal(t, map[string]interface{}{"accounts": []interface{}{}}, request["options"])
+
+	chanA := make(chan pb.SubscriptionEntry)
+	client.Subscribe(&chanA, []string{"nano_a"})
+	request = nextRequest(t, requests)
+	assert.Equal(t, "update", request["action"])
+	assert.Equal(t, map[string]interface{}{"accounts_add": []interface{}{"nano_a"}}, request["options"])
+
+	chanB := make(chan pb.SubscriptionEntry)
+	client.Subscribe(&chanB, []string{"nano_a", "nano_b"})
+	request = nextRequest(t, requests)
+	assert.Equal(t, map[string]interface{}{"accounts_add": []interface{}{"nano_b"}}, request["options"])
+
+	client.Unsubscribe(&chanB)
+	request = nextRequest(t, requests)
+	assert.Equal(t, map[string]interface{}{"accounts_del": []interface{}{"nano_b"}}, request["options"])
+
+	chanAll := make(chan pb.SubscriptionEntry)
+	client.Subscribe(&chanAll, []string{})
+	request = nextRequest(t, requests)
+	assert.Equal(t, "subscribe", request["action"])
+	assert.Nil(t, request["options"])
+
+	client.Unsubscribe(&chanAll)
+	request = nextRequest(t, requests)
+	assert.Equal(t, "subscribe", request["action"])
+	assert.Equal(t, map[string]interface{}{"accounts": []interface{}{"nano_a"}}, request["options"])
+}
+
+func TestSetFilter(t *testing.T) {
+	requests := make(chan map[string]interface{}, 10)
+	server := recordingNode(requests)
+	defer server.Close()
+
+	client := WSClient{}
+	client.Init(&ConfWS{URL: "ws" + strings.TrimPrefix(server.URL, "http")}, nil)
+	defer client.Close()
+
+	nextRequest(t, requests)
+
+	mychan := make(chan pb.SubscriptionEntry, 1)
+	_, err := client.SubscribeFilter(&mychan, Filter{Accounts: []string{"nano_a"}}, pb.OverflowPolicy_DISCONNECT)
+	require.Nil(t, err)
+	nextRequest(t, requests)
+
+	client.SetFilter(&mychan, Filter{Accounts: []string{"nano_b"}})
+	request := nextRequest(t, requests)
+	assert.Equal(t, map[string]interface{}{
+		"accounts_add": []interface{}{"nano_b"},
+		"accounts_del": []interface{}{"nano_a"},
+	}, request["options"])
+
+	client.subHandler(`{"topic": "confirmation", "message": {"account": "nano_a"}}`)
+	client.subHandler(`{"topic": "confirmation", "message": {"account": "nano_c", "block": {"link_as_account": "nano_b"}}}`)
+
+	entry := <-mychan
+	assert.Equal(t, "nano_c", entry.GetMessage().GetAccount())
+	assert.Empty(t, mychan)
+}
+
+func TestLocalAccountsNoFilterUpdates(t *testing.T) {
+	requests := make(chan map[string]interface{}, 10)
+	server := recordingNode(requests)
+	defer server.Close()
+
+	client := WSClient{LocalAccounts: true}
+	client.Init(&ConfWS{URL: "ws" + strings.TrimPrefix(server.URL, "http")}, nil)
+	defer client.Close()
+
+	request := nextRequest(t, requests)
+	assert.Equal(t, map[string]interface{}{"all_local_accounts": true}, request["options"])
+
+	mychan := make(chan pb.SubscriptionEntry)
+	client.Subscribe(&mychan, []string{"nano_a"})
+
+	select {
+	case request := <-requests:
+		assert.Fail(t, "unexpected request", request)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+type nopJournal struct{}
+
+func (nopJournal) Append(entry *pb.SubscriptionEntry) error {
+	return nil
+}
+
+func TestJournalKeepsAccountFilter(t *testing.T) {
+	requests := make(chan map[string]interface{}, 10)
+	server := recordingNode(requests)
+	defer server.Close()
+
+	client := WSClient{Journal: nopJournal{}}
+	client.Init(&ConfWS{URL: "ws" + strings.TrimPrefix(server.URL, "http")}, nil)
+	defer client.Close()
+
+	request := nextRequest(t, requests)
+	assert.Equal(t, map[string]interface{}{"accounts": []interface{}{}}, request["options"])
+
+	mychan := make(chan pb.SubscriptionEntry)
+	client.Subscribe(&mychan, []string{"nano_a"})
+	request = nextRequest(t, requests)
+	assert.Equal(t, map[string]interface{}{"accounts_add": []interface{}{"nano_a"}}, request["options"])
+}
+
+func TestJournalAllNoAccountFilter(t *testing.T) {
+	requests := make(chan map[string]interface{}, 10)
+	server := recordingNode(requests)
+	defer server.Close()
+
+	client := WSClient{Journal: nopJournal{}, JournalAll: true}
+	client.Init(&ConfWS{URL: "ws" + strings.TrimPrefix(server.URL, "http")}, nil)
+	defer client.Close()
+
+	request := nextRequest(t, requests)
+	assert.Equal(t, "subscribe", request["action"])
+	assert.Nil(t, request["options"])
+
+	// Filtering happens in the gateway only, the journal gets every confirmation
+	mychan := make(chan pb.SubscriptionEntry)
+	client.Subscribe(&mychan, []string{"nano_a"})
+	client.Unsubscribe(&mychan)
+
+	select {
+	case request := <-requests:
+		assert.Fail(t, "unexpected request", request)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func entryAt(time string) pb.SubscriptionEntry {
+	return pb.SubscriptionEntry{Topic: "confirmation", Time: time}
+}
+
+func TestOverflowDropOldest(t *testing.T) {
+	client := WSClient{}
+	mychan := make(chan pb.SubscriptionEntry, 2)
+	subscription, err := client.SubscribeFilter(&mychan, Filter{}, pb.OverflowPolicy_DROP_OLDEST)
+	require.Nil(t, err)
+
+	for _, ts := range []string{"1", "2", "3", "4"} {
+		subscription.deliver(entryAt(ts))
+	}
+
+	assert.Equal(t, uint64(2), subscription.Dropped())
+	first, second := <-mychan, <-mychan
+	assert.Equal(t, "3", first.Time)
+	assert.Equal(t, "4", second.Time)
+	assert.Equal(t, uint64(2), second.Dropped)
+}
+
+func TestOverflowDisconnect(t *testing.T) {
+	client := WSClient{}
+	mychan := make(chan pb.SubscriptionEntry, 1)
+	subscription, err := client.SubscribeFilter(&mychan, Filter{}, pb.OverflowPolicy_DISCONNECT)
+	require.Nil(t, err)
+
+	subscription.deliver(entryAt("1"))
+	select {
+	case <-subscription.Overflowed():
+		assert.Fail(t, "overflowed too early")
+	default:
+	}
+
+	subscription.deliver(entryAt("2"))
+	select {
+	case <-subscription.Overflowed():
+	default:
+		assert.Fail(t, "not overflowed")
+	}
+	assert.Equal(t, uint64(1), subscription.Dropped())
+}
+
+func TestOverflowBlockReleasedOnUnsubscribe(t *testing.T) {
+	client := WSClient{}
+	mychan := make(chan pb.SubscriptionEntry)
+	subscription, err := client.SubscribeFilter(&mychan, Filter{}, pb.OverflowPolicy_BLOCK)
+	require.Nil(t, err)
+
+	delivered := make(chan struct{})
+	go func() {
+		subscription.deliver(entryAt("1"))
+		close(delivered)
+	}()
+
+	select {
+	case <-delivered:
+		assert.Fail(t, "delivery did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	client.Unsubscribe(&mychan)
+
+	select {
+	case <-delivered:
+	case <-time.After(3 * time.Second):
+		assert.Fail(t, "delivery still blocked")
+	}
+	assert.Equal(t, uint64(0), subscription.Dropped())
+}
+
+func TestOverflowBlockTimeout(t *testing.T) {
+	client := WSClient{BlockTimeout: 50 * time.Millisecond}
+	mychan := make(chan pb.SubscriptionEntry, 1)
+	subscription, err := client.SubscribeFilter(&mychan, Filter{}, pb.OverflowPolicy_BLOCK)
+	require.Nil(t, err)
+
+	subscription.deliver(entryAt("1"))
+
+	start := time.Now()
+	subscription.deliver(entryAt("2"))
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+
+	select {
+	case <-subscription.Overflowed():
+	default:
+		assert.Fail(t, "not overflowed")
+	}
+	assert.Equal(t, uint64(1), subscription.Dropped())
+
+	// Once disconnected, deliveries no longer wait
+	start = time.Now()
+	subscription.deliver(entryAt("3"))
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+}
+
+func TestOverflowDropOldestUnbuffered(t *testing.T) {
+	client := WSClient{}
+	mychan := make(chan pb.SubscriptionEntry)
+
+	_, err := client.SubscribeFilter(&mychan, Filter{}, pb.OverflowPolicy_DROP_OLDEST)
+	assert.NotNil(t, err)
+
+	_, err = client.SubscribeTopic(TopicVote, nil, 0, pb.OverflowPolicy_DROP_OLDEST)
+	assert.NotNil(t, err)
+}
+
+func TestSequenceStamping(t *testing.T) {
+	client := WSClient{logger: log.NewEntry(log.New())}
+
+	mychan := make(chan pb.SubscriptionEntry, 2)
+	client.Subscribe(&mychan, []string{})
+
+	client.subHandler(MESSAGE)
+	client.subHandler(MESSAGE)
+
+	first, second := <-mychan, <-mychan
+	assert.Equal(t, uint64(1), first.Sequence)
+	assert.Equal(t, uint64(2), second.Sequence)
+}
+
+func TestUnknownConfirmationFields(t *testing.T) {
+	client := WSClient{logger: log.NewEntry(log.New())}
+
+	mychan := make(chan pb.SubscriptionEntry, 1)
+	client.Subscribe(&mychan, []string{})
+
+	client.subHandler(`{"topic": "confirmation", "time": "1", "added_later": "x",
+		"message": {"account": "nano_a", "new_field": {"a": 1}}}`)
+
+	select {
+	case entry := <-mychan:
+		assert.Equal(t, "nano_a", entry.GetMessage().GetAccount())
+	default:
+		assert.Fail(t, "confirmation not delivered")
+	}
+}
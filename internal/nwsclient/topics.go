@@ -0,0 +1,230 @@
+package nwsclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/proto"
+	"strings"
+	"time"
+)
+
+// Websocket topics besides confirmation
+const (
+	TopicVote                = "vote"
+	TopicStoppedElection     = "stopped_election"
+	TopicActiveDifficulty    = "active_difficulty"
+	TopicWork                = "work"
+	TopicTelemetry           = "telemetry"
+	TopicNewUnconfirmedBlock = "new_unconfirmed_block"
+	TopicBootstrap           = "bootstrap"
+)
+
+// topicEntries returns the typed entry each topic message is unmarshaled into
+var topicEntries = map[string]func() proto.Message{
+	TopicVote:                func() proto.Message { return &pb.VoteEntry{} },
+	TopicStoppedElection:     func() proto.Message { return &pb.StoppedElectionEntry{} },
+	TopicActiveDifficulty:    func() proto.Message { return &pb.ActiveDifficultyEntry{} },
+	TopicWork:                func() proto.Message { return &pb.WorkEntry{} },
+	TopicTelemetry:           func() proto.Message { return &pb.TelemetryEntry{} },
+	TopicNewUnconfirmedBlock: func() proto.Message { return &pb.NewUnconfirmedBlockEntry{} },
+	TopicBootstrap:           func() proto.Message { return &pb.BootstrapEntry{} },
+}
+
+// A TopicSubscription receives the typed entries of a topic other than confirmation
+type TopicSubscription struct {
+	outbox
+	topic   string
+	channel chan proto.Message
+	// Representatives whose votes are delivered, all if empty
+	accounts []string
+}
+
+// Entries returns the channel the entries of the topic are delivered to
+func (s *TopicSubscription) Entries() <-chan proto.Message {
+	return s.channel
+}
+
+func (s *TopicSubscription) matches(entry proto.Message) bool {
+	if len(s.accounts) == 0 {
+		return true
+	}
+
+	if vote, ok := entry.(*pb.VoteEntry); ok {
+		return stringInSlice(vote.GetMessage().GetAccount(), s.accounts)
+	}
+	return true
+}
+
+// deliver sends entry to the subscriber channel applying the overflow policy
+func (s *TopicSubscription) deliver(entry proto.Message) {
+	s.outbox.deliver(
+		func() bool {
+			select {
+			case s.channel <- entry:
+				return true
+			default:
+				return false
+			}
+		},
+		func(done <-chan struct{}, timeout <-chan time.Time) bool {
+			select {
+			case s.channel <- entry:
+			case <-done:
+			case <-timeout:
+				return false
+			}
+			return true
+		},
+		func() bool {
+			select {
+			case <-s.channel:
+				return true
+			default:
+				return false
+			}
+		})
+}
+
+// activeTopics returns the topics with at least one subscription
+func (client *WSClient) activeTopics() map[string]bool {
+	topics := make(map[string]bool)
+
+	client.topicSubscriptions.Range(
+		func(key, value interface{}) bool {
+			topics[value.(*TopicSubscription).topic] = true
+			return true
+		})
+
+	return topics
+}
+
+// syncTopics subscribes the node to the topics with active subscriptions and
+// unsubscribes it from the others. Must be called with filterMutex held.
+func (client *WSClient) syncTopics() error {
+	if client.nodeTopics == nil {
+		client.nodeTopics = make(map[string]bool)
+	}
+
+	active := client.activeTopics()
+
+	for _, topic := range sortedKeys(active) {
+		if client.nodeTopics[topic] {
+			continue
+		}
+
+		request := map[string]interface{}{
+			"action": "subscribe",
+			"topic":  topic,
+			"ack":    "false",
+		}
+		client.logger.Debug("Request: ", request)
+
+		if err := client.write(request); err != nil {
+			return err
+		}
+		client.nodeTopics[topic] = true
+	}
+
+	for _, topic := range sortedKeys(client.nodeTopics) {
+		if active[topic] {
+			continue
+		}
+
+		request := map[string]interface{}{
+			"action": "unsubscribe",
+			"topic":  topic,
+			"ack":    "false",
+		}
+		client.logger.Debug("Request: ", request)
+
+		if err := client.write(request); err != nil {
+			return err
+		}
+		delete(client.nodeTopics, topic)
+	}
+
+	return nil
+}
+
+// updateTopics pushes changes in the subscribed topics to the node. While
+// disconnected nothing is sent, as every topic is subscribed again on connection.
+func (client *WSClient) updateTopics() {
+	client.filterMutex.Lock()
+	defer client.filterMutex.Unlock()
+
+	if !client.Connected() {
+		return
+	}
+
+	if err := client.syncTopics(); err != nil {
+		// Topics are subscribed again on reconnection
+		client.logger.Error("updating topics:", err)
+	}
+}
+
+// messageTopic returns the topic of a message received from the node
+func messageTopic(message string) string {
+	header := struct {
+		Topic string `json:"topic"`
+	}{}
+
+	_ = json.Unmarshal([]byte(message), &header)
+	return header.Topic
+}
+
+// topicHandler delivers a message of a topic other than confirmation
+func (client *WSClient) topicHandler(topic string, message string) {
+	entry := topicEntries[topic]()
+
+	if err := unmarshaler.Unmarshal(strings.NewReader(message), entry); err != nil {
+		client.logger.Error("error unmarshaling ", topic, " message: ", err.Error())
+		return
+	}
+
+	client.logger.Debugln("received:", entry)
+
+	client.topicSubscriptions.Range(
+		func(key, value interface{}) bool {
+			subscription := value.(*TopicSubscription)
+
+			if subscription.topic == topic && subscription.matches(entry) {
+				subscription.deliver(entry)
+			}
+			return true
+		})
+}
+
+// SubscribeTopic delivers the entries of topic to a channel of the given
+// capacity. For the vote topic, accounts restricts the votes to those of the
+// given representatives. policy tells what to do when the channel is full,
+// DROP_OLDEST requires a positive capacity.
+func (client *WSClient) SubscribeTopic(topic string, accounts []string, buffer int,
+	policy pb.OverflowPolicy) (*TopicSubscription, error) {
+	if _, ok := topicEntries[topic]; !ok {
+		return nil, fmt.Errorf("unknown topic %q", topic)
+	}
+	if policy == pb.OverflowPolicy_DROP_OLDEST && buffer <= 0 {
+		return nil, errors.New("DROP_OLDEST requires a buffered channel")
+	}
+
+	s := TopicSubscription{
+		outbox:   newOutbox(policy, client.BlockTimeout),
+		topic:    topic,
+		channel:  make(chan proto.Message, buffer),
+		accounts: accounts,
+	}
+
+	client.topicSubscriptions.Store(&s, &s)
+	client.updateTopics()
+	return &s, nil
+}
+
+// UnsubscribeTopic stops the delivery to subscription. The node is unsubscribed
+// from the topic when its last subscription is removed.
+func (client *WSClient) UnsubscribeTopic(subscription *TopicSubscription) {
+	subscription.close()
+	client.topicSubscriptions.Delete(subscription)
+	client.updateTopics()
+}
@@ -0,0 +1,133 @@
+package nwsclient
+
+import (
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+var VOTE = `
+{
+  "topic": "vote",
+  "time": "1564935350664",
+  "message": {
+    "account": "nano_1n5aisgwmq1oibg8c7aerrubboccp3mfcjgm8jaas1fwhxmcndaf4jrt75fy",
+    "signature": "1950700796914893705657789944906107642480343124305202910152471520450456881722545967829502369630995363643731706156278026749554294222131169148120786048025353",
+    "sequence": "855471574",
+    "blocks": ["6FB9DE5D7908DEB8A2EA391AEA95041587CBF3420EF8A606F1489FECEE75C869"],
+    "type": "vote",
+    "timestamp": "18446744073709551615"
+  }
+}
+`
+
+var ACTIVE_DIFFICULTY = `
+{
+  "topic": "active_difficulty",
+  "time": "1561661740065",
+  "message": {
+    "multiplier": "1.5",
+    "network_current": "fffffffaf2d4f1d6",
+    "network_minimum": "fffffff800000000"
+  }
+}
+`
+
+func receiveTopic(t *testing.T, s *TopicSubscription) proto.Message {
+	select {
+	case entry := <-s.Entries():
+		return entry
+	case <-time.After(3 * time.Second):
+		require.Fail(t, "Timeout")
+	}
+	return nil
+}
+
+func TestTopicHandler(t *testing.T) {
+	client := WSClient{logger: log.NewEntry(log.New())}
+
+	votes, err := client.SubscribeTopic(TopicVote, nil, 1, pb.OverflowPolicy_DISCONNECT)
+	require.Nil(t, err)
+	other, err := client.SubscribeTopic(TopicVote, []string{"nano_other"}, 1, pb.OverflowPolicy_DISCONNECT)
+	require.Nil(t, err)
+	difficulty, err := client.SubscribeTopic(TopicActiveDifficulty, nil, 1, pb.OverflowPolicy_DISCONNECT)
+	require.Nil(t, err)
+
+	client.subHandler(VOTE)
+	client.subHandler(ACTIVE_DIFFICULTY)
+
+	vote := receiveTopic(t, votes).(*pb.VoteEntry)
+	assert.Equal(t, "855471574", vote.Message.Sequence)
+	assert.Equal(t, []string{"6FB9DE5D7908DEB8A2EA391AEA95041587CBF3420EF8A606F1489FECEE75C869"}, vote.Message.Blocks)
+
+	active := receiveTopic(t, difficulty).(*pb.ActiveDifficultyEntry)
+	assert.Equal(t, "1.5", active.Message.Multiplier)
+
+	assert.Equal(t, 0, len(other.Entries()))
+	assert.Equal(t, 0, len(votes.Entries()))
+}
+
+func TestSubscribeUnknownTopic(t *testing.T) {
+	client := WSClient{logger: log.NewEntry(log.New())}
+
+	_, err := client.SubscribeTopic("confirmation", nil, 1, pb.OverflowPolicy_DISCONNECT)
+	assert.Error(t, err)
+}
+
+func TestTopicSubscriptions(t *testing.T) {
+	requests := make(chan map[string]interface{}, 10)
+	server := recordingNode(requests)
+	defer server.Close()
+
+	client := WSClient{}
+	client.Init(&ConfWS{URL: "ws" + strings.TrimPrefix(server.URL, "http")}, nil)
+	defer client.Close()
+
+	request := nextRequest(t, requests)
+	assert.Equal(t, "confirmation", request["topic"])
+
+	first, _ := client.SubscribeTopic(TopicVote, nil, 1, pb.OverflowPolicy_DISCONNECT)
+	request = nextRequest(t, requests)
+	assert.Equal(t, "subscribe", request["action"])
+	assert.Equal(t, "vote", request["topic"])
+
+	second, _ := client.SubscribeTopic(TopicVote, nil, 1, pb.OverflowPolicy_DISCONNECT)
+	client.UnsubscribeTopic(first)
+
+	select {
+	case request := <-requests:
+		assert.Fail(t, "unexpected request", request)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	client.UnsubscribeTopic(second)
+	request = nextRequest(t, requests)
+	assert.Equal(t, "unsubscribe", request["action"])
+	assert.Equal(t, "vote", request["topic"])
+}
+
+func TestTopicsResubscribedOnReconnect(t *testing.T) {
+	node := newFakeNode()
+	defer node.server.Close()
+
+	client := WSClient{MinBackoff: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond}
+	_, _ = client.SubscribeTopic(TopicTelemetry, nil, 1, pb.OverflowPolicy_DISCONNECT)
+	client.Init(&ConfWS{URL: node.url()}, nil)
+	defer client.Close()
+
+	ch := make(chan pb.SubscriptionEntry, 10)
+	client.Subscribe(&ch, []string{})
+
+	// The node streams a confirmation only after the second subscribe
+	for entry := receive(t, ch); entry.Topic != "confirmation"; entry = receive(t, ch) {
+	}
+
+	client.filterMutex.Lock()
+	defer client.filterMutex.Unlock()
+	assert.Equal(t, map[string]bool{TopicTelemetry: true}, client.nodeTopics)
+}
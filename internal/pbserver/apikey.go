@@ -0,0 +1,183 @@
+package pbserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metadata key carrying the API key of a request
+const apiKeyMetadata = "x-api-key"
+
+// An APIKey is an entry of the key file. Only the hash of the key is stored.
+type APIKey struct {
+	Label string `json:"label"`
+	// Hex encoded SHA-256 of the key, see HashAPIKey
+	Hash   string   `json:"hash"`
+	Scopes []string `json:"scopes"`
+	// Requests per second, unlimited if 0
+	RateLimit float64 `json:"rate_limit"`
+	// Wallets and source accounts allowed in Send, any if empty
+	Wallets []string `json:"wallets,omitempty"`
+	Sources []string `json:"sources,omitempty"`
+}
+
+// HashAPIKey returns the hash of key as stored in the key file
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// claims returns the key as the claims of a token, so that the same policy applies
+func (key *APIKey) claims() jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub":   key.Label,
+		"scope": strings.Join(key.Scopes, " "),
+	}
+
+	if len(key.Wallets) > 0 {
+		claims[claimWallets] = strings.Join(key.Wallets, " ")
+	}
+	if len(key.Sources) > 0 {
+		claims[claimSources] = strings.Join(key.Sources, " ")
+	}
+
+	return claims
+}
+
+// limiter is a token bucket allowing rate requests per second, with bursts of
+// up to one second of requests
+type limiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64) *limiter {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &limiter{rate: rate, tokens: burst, last: time.Now()}
+}
+
+func (l *limiter) allow() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	burst := l.rate
+	if burst < 1 {
+		burst = 1
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > burst {
+		l.tokens = burst
+	}
+	l.last = now
+
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+type apiKeyEntry struct {
+	key     APIKey
+	limiter *limiter
+}
+
+// A KeyStore holds the API keys read from a key file
+type KeyStore struct {
+	path  string
+	mutex sync.RWMutex
+	keys  map[string]*apiKeyEntry
+}
+
+// LoadKeyStore reads the API keys of a JSON key file
+func LoadKeyStore(path string) (*KeyStore, error) {
+	store := &KeyStore{path: path}
+
+	if err := store.Reload(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// Reload reads the key file again. The current keys are kept if it is invalid.
+// The rate of unchanged keys is not reset.
+func (store *KeyStore) Reload() error {
+	data, err := ioutil.ReadFile(store.path)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]APIKey, 0)
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("%s: %s", store.path, err)
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	entries := make(map[string]*apiKeyEntry)
+
+	for _, key := range keys {
+		hash := strings.ToLower(key.Hash)
+		if _, err := hex.DecodeString(hash); err != nil || len(hash) != sha256.Size*2 {
+			return fmt.Errorf("%s: invalid hash for key %q", store.path, key.Label)
+		}
+
+		if _, ok := entries[hash]; ok {
+			return fmt.Errorf("%s: duplicate key %q", store.path, key.Label)
+		}
+
+		entry := &apiKeyEntry{key: key}
+		if key.RateLimit > 0 {
+			if old, ok := store.keys[hash]; ok && old.limiter != nil && old.key.RateLimit == key.RateLimit {
+				entry.limiter = old.limiter
+			} else {
+				entry.limiter = newLimiter(key.RateLimit)
+			}
+		}
+		entries[hash] = entry
+	}
+
+	store.keys = entries
+	return nil
+}
+
+// Len returns the number of keys
+func (store *KeyStore) Len() int {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	return len(store.keys)
+}
+
+// verify returns the claims of a valid key within its rate limit
+func (store *KeyStore) verify(key string) (jwt.MapClaims, error) {
+	store.mutex.RLock()
+	entry, ok := store.keys[HashAPIKey(key)]
+	store.mutex.RUnlock()
+
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+
+	if entry.limiter != nil && !entry.limiter.allow() {
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit of api key %q exceeded", entry.key.Label)
+	}
+
+	return entry.key.claims(), nil
+}
@@ -0,0 +1,107 @@
+package pbserver
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func keyFile(t *testing.T, content string) string {
+	f, err := ioutil.TempFile("", "apikeys")
+	require.Nil(t, err)
+	_, err = f.WriteString(content)
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+	return f.Name()
+}
+
+func TestAPIKeyAuthentication(t *testing.T) {
+	path := keyFile(t, fmt.Sprintf(`[
+		{"label": "dashboard", "hash": "%s", "scopes": ["read"]},
+		{"label": "payments", "hash": "%s", "scopes": ["read", "wallet-write"], "wallets": ["W1"]}
+	]`, HashAPIKey("dashboard-key"), HashAPIKey("payments-key")))
+	defer os.Remove(path)
+
+	store, err := LoadKeyStore(path)
+	require.Nil(t, err)
+	assert.Equal(t, 2, store.Len())
+
+	server := &Server{APIKeys: store}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return claimsFromContext(ctx)["sub"], nil
+	}
+	call := func(key string, method string, req interface{}) (interface{}, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyMetadata, key))
+		return EnsureValidToken(ctx, req, &grpc.UnaryServerInfo{Server: server, FullMethod: method}, handler)
+	}
+
+	reply, err := call("dashboard-key", "/nanoproto.Nano/BlockInfo", nil)
+	require.Nil(t, err)
+	assert.Equal(t, "dashboard", reply)
+
+	_, err = call("dashboard-key", "/nanoproto.Nano/Send", &pb.SendRequest{Wallet: "W1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	reply, err = call("payments-key", "/nanoproto.Nano/Send", &pb.SendRequest{Wallet: "W1"})
+	require.Nil(t, err)
+	assert.Equal(t, "payments", reply)
+
+	_, err = call("payments-key", "/nanoproto.Nano/Send", &pb.SendRequest{Wallet: "W2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call("wrong-key", "/nanoproto.Nano/BlockInfo", nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+	_, err = EnsureValidToken(ctx, nil, &grpc.UnaryServerInfo{Server: server}, handler)
+	assert.Equal(t, errMissingAPIKey, err)
+}
+
+func TestAPIKeyRateLimit(t *testing.T) {
+	path := keyFile(t, fmt.Sprintf(`[{"label": "slow", "hash": "%s", "scopes": ["read"], "rate_limit": 2}]`,
+		HashAPIKey("slow-key")))
+	defer os.Remove(path)
+
+	store, err := LoadKeyStore(path)
+	require.Nil(t, err)
+
+	_, err = store.verify("slow-key")
+	assert.Nil(t, err)
+	_, err = store.verify("slow-key")
+	assert.Nil(t, err)
+	_, err = store.verify("slow-key")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAPIKeyReload(t *testing.T) {
+	path := keyFile(t, fmt.Sprintf(`[{"label": "old", "hash": "%s", "scopes": ["read"]}]`,
+		HashAPIKey("old-key")))
+	defer os.Remove(path)
+
+	store, err := LoadKeyStore(path)
+	require.Nil(t, err)
+
+	require.Nil(t, ioutil.WriteFile(path, []byte(fmt.Sprintf(
+		`[{"label": "new", "hash": "%s", "scopes": ["read"]}]`, HashAPIKey("new-key"))), 0600))
+	require.Nil(t, store.Reload())
+
+	_, err = store.verify("old-key")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = store.verify("new-key")
+	assert.Nil(t, err)
+
+	// An invalid file keeps the current keys
+	require.Nil(t, ioutil.WriteFile(path, []byte(`[{"label": "bad", "hash": "xyz"}]`), 0600))
+	assert.Error(t, store.Reload())
+	_, err = store.verify("new-key")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,231 @@
+package pbserver
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"strings"
+)
+
+var (
+	errMissingMetadata = status.Errorf(codes.Unauthenticated, "missing metadata")
+	errMissingToken    = status.Errorf(codes.Unauthenticated, "missing token")
+	errMissingAPIKey   = status.Errorf(codes.Unauthenticated, "missing api key")
+)
+
+type claimsKey struct{}
+
+// LoadPubKey reads the PEM encoded RSA key verifying the JWT of the requests
+func (server *Server) LoadPubKey(filename string) error {
+	keyData, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	key, err := jwt.ParseRSAPublicKeyFromPEM(keyData)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err)
+	}
+
+	server.PubKey = keyData
+	server.jwtKey = key
+	return nil
+}
+
+// verifyKey returns the key verifying the signature of the tokens
+func (server *Server) verifyKey() (*rsa.PublicKey, error) {
+	if server.jwtKey != nil {
+		return server.jwtKey, nil
+	}
+	return jwt.ParseRSAPublicKeyFromPEM(server.PubKey)
+}
+
+// hasAudience reports whether the aud claim, a string or a list, contains audience
+func hasAudience(claims jwt.MapClaims, audience string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifyToken checks the signature of a token and its claims. exp is required
+// unless allowNoExp, nbf is checked when present, aud and iss are required
+// unless empty.
+func verifyToken(raw string, key *rsa.PublicKey, audience string, issuer string,
+	allowNoExp bool) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key, nil
+	})
+
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+		}
+
+		// Time claims are only meaningful for a token with a valid signature
+		switch {
+		case verr.Errors&(jwt.ValidationErrorMalformed|jwt.ValidationErrorUnverifiable|
+			jwt.ValidationErrorSignatureInvalid) != 0:
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", verr)
+		case verr.Errors&jwt.ValidationErrorExpired != 0:
+			return nil, status.Error(codes.Unauthenticated, "token expired")
+		case verr.Errors&jwt.ValidationErrorNotValidYet != 0:
+			return nil, status.Error(codes.Unauthenticated, "token not valid yet")
+		default:
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", verr)
+		}
+	}
+
+	if _, ok := claims["exp"]; !ok && !allowNoExp {
+		return nil, status.Error(codes.Unauthenticated, "token without expiration")
+	}
+
+	if audience != "" && !hasAudience(claims, audience) {
+		return nil, status.Error(codes.Unauthenticated, "token audience mismatch")
+	}
+
+	if issuer != "" && !claims.VerifyIssuer(issuer, true) {
+		return nil, status.Error(codes.Unauthenticated, "token issuer mismatch")
+	}
+
+	return claims, nil
+}
+
+// tokenFromMetadata returns the token of the auth-token-bin key, or of a
+// bearer authorization
+func tokenFromMetadata(md metadata.MD) string {
+	// The keys within metadata.MD are normalized to lowercase.
+	// See: https://godoc.org/google.golang.org/grpc/metadata#New
+	if token := md["auth-token-bin"]; len(token) > 0 {
+		return token[0]
+	}
+
+	if authorization := md["authorization"]; len(authorization) > 0 {
+		const prefix = "bearer "
+		if len(authorization[0]) > len(prefix) && strings.EqualFold(authorization[0][:len(prefix)], prefix) {
+			return strings.TrimSpace(authorization[0][len(prefix):])
+		}
+	}
+
+	return ""
+}
+
+// authenticate verifies the API key or else the token of an incoming request
+// and returns a context carrying its claims
+func (server *Server) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errMissingMetadata
+	}
+
+	if key := md[apiKeyMetadata]; len(key) > 0 {
+		if server.APIKeys == nil {
+			return nil, status.Error(codes.Unauthenticated, "api keys not enabled")
+		}
+
+		claims, err := server.APIKeys.verify(key[0])
+		if err != nil {
+			return nil, err
+		}
+		return context.WithValue(ctx, claimsKey{}, claims), nil
+	}
+
+	if server.jwtKey == nil && len(server.PubKey) == 0 {
+		return nil, errMissingAPIKey
+	}
+
+	raw := tokenFromMetadata(md)
+	if raw == "" {
+		return nil, errMissingToken
+	}
+
+	key, err := server.verifyKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid verification key: %s", err)
+	}
+
+	claims, err := verifyToken(raw, key, server.Audience, server.Issuer, server.AllowNoExp)
+	if err != nil {
+		return nil, err
+	}
+
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+// claimsFromContext returns the claims of an authenticated request, nil if none
+func claimsFromContext(ctx context.Context) jwt.MapClaims {
+	claims, _ := ctx.Value(claimsKey{}).(jwt.MapClaims)
+	return claims
+}
+
+// serverOf returns the Server behind a registered service implementation
+func serverOf(srv interface{}) *Server {
+	if v2, ok := srv.(*ServerV2); ok {
+		return v2.server
+	}
+	return srv.(*Server)
+}
+
+// EnsureValidToken ensures a valid token or API key exists within a request's
+// metadata. If both are missing or invalid, the interceptor blocks execution of the
+// handler and returns an error. The claims of the token must also grant the
+// scope the server policy requires for the method. Otherwise, the interceptor
+// invokes the unary handler.
+func EnsureValidToken(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	server := serverOf(info.Server)
+
+	ctx, err := server.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	// Continue execution of handler after ensuring a valid token.
+	return handler(ctx, req)
+}
+
+// authStream overrides the context of a stream with the authenticated one
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// EnsureValidTokenStream is the EnsureValidToken interceptor of streaming RPCs
+func EnsureValidTokenStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	server := serverOf(srv)
+
+	ctx, err := server.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	if err := server.authorize(ctx, info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
@@ -0,0 +1,152 @@
+package pbserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestValid(t *testing.T) {
+	key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(pubkey))
+	require.Nil(t, err)
+
+	claims, err := verifyToken(token, key, "", "", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "payload", claims["some"])
+}
+
+func TestValidWrongKey(t *testing.T) {
+	key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(pubkeywrong))
+	require.Nil(t, err)
+
+	_, err = verifyToken(token, key, "", "", true)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func signedToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	require.Nil(t, err)
+	return signed
+}
+
+func TestVerifyTokenClaims(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		err    string
+	}{
+		{"valid", jwt.MapClaims{"exp": now + 60, "aud": "nanopb", "iss": "issuer"}, ""},
+		{"audience list", jwt.MapClaims{"exp": now + 60, "aud": []string{"other", "nanopb"}, "iss": "issuer"}, ""},
+		{"expired", jwt.MapClaims{"exp": now - 60, "aud": "nanopb", "iss": "issuer"}, "token expired"},
+		{"missing exp", jwt.MapClaims{"aud": "nanopb", "iss": "issuer"}, "token without expiration"},
+		{"not valid yet", jwt.MapClaims{"exp": now + 120, "nbf": now + 60, "aud": "nanopb", "iss": "issuer"},
+			"token not valid yet"},
+		{"wrong audience", jwt.MapClaims{"exp": now + 60, "aud": "other", "iss": "issuer"}, "token audience mismatch"},
+		{"missing audience", jwt.MapClaims{"exp": now + 60, "iss": "issuer"}, "token audience mismatch"},
+		{"wrong issuer", jwt.MapClaims{"exp": now + 60, "aud": "nanopb", "iss": "other"}, "token issuer mismatch"},
+	}
+
+	for _, test := range tests {
+		_, err := verifyToken(signedToken(t, key, test.claims), &key.PublicKey, "nanopb", "issuer", false)
+		if test.err == "" {
+			assert.Nil(t, err, test.name)
+			continue
+		}
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), test.name)
+		assert.Equal(t, test.err, status.Convert(err).Message(), test.name)
+	}
+
+	noExp := signedToken(t, key, jwt.MapClaims{"aud": "nanopb", "iss": "issuer"})
+	_, err = verifyToken(noExp, &key.PublicKey, "nanopb", "issuer", true)
+	assert.Nil(t, err)
+}
+
+func TestVerifyTokenWrongAlgorithm(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{}).SignedString([]byte("secret"))
+	require.Nil(t, err)
+
+	_, err = verifyToken(signed, &key.PublicKey, "", "", true)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestEnsureValidToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	server := &Server{jwtKey: &key.PublicKey}
+	info := &grpc.UnaryServerInfo{Server: server, FullMethod: "/nanoproto.Nano/BlockInfo"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return claimsFromContext(ctx)["sub"], nil
+	}
+
+	_, err = EnsureValidToken(context.Background(), nil, info, handler)
+	assert.Equal(t, errMissingMetadata, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+	_, err = EnsureValidToken(ctx, nil, info, handler)
+	assert.Equal(t, errMissingToken, err)
+
+	signed := signedToken(t, key, jwt.MapClaims{"sub": "client", "scope": ScopeRead, "exp": time.Now().Unix() + 60})
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("auth-token-bin", signed))
+	reply, err := EnsureValidToken(ctx, nil, info, handler)
+	require.Nil(t, err)
+	assert.Equal(t, "client", reply)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signed))
+	reply, err = EnsureValidToken(ctx, nil, info, handler)
+	require.Nil(t, err)
+	assert.Equal(t, "client", reply)
+}
+
+func TestEnsureValidTokenStream(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	server := &Server{jwtKey: &key.PublicKey}
+	var sub interface{}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		sub = claimsFromContext(stream.Context())["sub"]
+		return nil
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/nanoproto.Nano/Subscribe"}
+
+	expired := signedToken(t, key, jwt.MapClaims{"sub": "client", "scope": ScopeRead, "exp": time.Now().Unix() - 60})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("auth-token-bin", expired))
+	err = EnsureValidTokenStream(server, &fakeServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Nil(t, sub)
+
+	signed := signedToken(t, key, jwt.MapClaims{"sub": "client", "scope": ScopeRead, "exp": time.Now().Unix() + 60})
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("auth-token-bin", signed))
+	err = EnsureValidTokenStream(server, &fakeServerStream{ctx: ctx}, info, handler)
+	require.Nil(t, err)
+	assert.Equal(t, "client", sub)
+}
@@ -0,0 +1,115 @@
+package pbserver
+
+import (
+	"bytes"
+	"context"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc/codes"
+)
+
+// Newer nodes add fields to the block info
+var blocksUnmarshaler = jsonpb.Unmarshaler{AllowUnknownFields: true}
+
+// blocksBatch is a chunk of the hashes of a BlocksInfo request
+type blocksBatch struct {
+	hashes  []string
+	replies []*pb.BlocksInfoReply
+	err     error
+	done    chan struct{}
+}
+
+// fetchBlocks requests the info of hashes with a single blocks_info
+func (server *Server) fetchBlocks(ctx context.Context, hashes []string, includeNotFound bool) ([]*pb.BlocksInfoReply, error) {
+	transform := TransformOpt{
+		"json_block":        str("true"),
+		"include_not_found": boolToStr(),
+	}
+
+	request, _ := getAction(&pb.BlocksInfoRequest{Hashes: hashes, IncludeNotFound: includeNotFound},
+		"blocks_info", transform)
+
+	reply, err := server.call(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	replies := make([]*pb.BlocksInfoReply, 0, len(hashes))
+
+	for _, hash := range hashes {
+		child := reply.Search("blocks", hash)
+
+		if child == nil || child.Data() == nil {
+			if !includeNotFound {
+				return nil, errorStatus(codes.Internal, "unexpected reply from node", reply.String())
+			}
+			replies = append(replies, &pb.BlocksInfoReply{BlockHash: hash, NotFound: true})
+			continue
+		}
+
+		info := pb.BlockInfoReply{}
+		if err := blocksUnmarshaler.Unmarshal(bytes.NewReader(child.Bytes()), &info); err != nil {
+			return nil, errorStatus(codes.Internal, "unexpected block info from node", child.String())
+		}
+
+		replies = append(replies, &pb.BlocksInfoReply{BlockHash: hash, Block: &info})
+	}
+
+	return replies, nil
+}
+
+// BlocksInfo streams the info of the hashes in request order. The hashes are
+// split in blocks_info batches fetched concurrently, one per IPC session.
+// blocks_info has no flatbuffers counterpart, the batches are JSON whatever
+// the configured encoding.
+func (server *Server) BlocksInfo(request *pb.BlocksInfoRequest, stream pb.Nano_BlocksInfoServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	size := server.blocksInfoBatch()
+	batches := make([]*blocksBatch, 0, len(request.Hashes)/size+1)
+
+	for start := 0; start < len(request.Hashes); start += size {
+		end := start + size
+		if end > len(request.Hashes) {
+			end = len(request.Hashes)
+		}
+		batches = append(batches, &blocksBatch{hashes: request.Hashes[start:end], done: make(chan struct{})})
+	}
+
+	slots := make(chan struct{}, server.poolSize())
+
+	go func() {
+		for _, batch := range batches {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				batch.err = contextStatus(ctx)
+				close(batch.done)
+				continue
+			}
+
+			go func(batch *blocksBatch) {
+				batch.replies, batch.err = server.fetchBlocks(ctx, batch.hashes, request.IncludeNotFound)
+				<-slots
+				close(batch.done)
+			}(batch)
+		}
+	}()
+
+	for _, batch := range batches {
+		<-batch.done
+
+		if batch.err != nil {
+			return batch.err
+		}
+
+		for _, reply := range batch.replies {
+			if err := stream.Send(reply); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type blocksStream struct {
+	fakeServerStream
+	replies []*pb.BlocksInfoReply
+}
+
+func (s *blocksStream) Send(reply *pb.BlocksInfoReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+// blocksInfoNode answers blocks_info with the blocks whose hash does not start with "X"
+func blocksInfoNode(client *mocks.IUSClient, requests *[][]string) {
+	var mutex sync.Mutex
+
+	client.On("Get", mock.Anything, mock.Anything).Return(func(ctx context.Context, request []byte) []byte {
+		action := struct {
+			Hashes []string `json:"hashes"`
+		}{}
+		_ = json.Unmarshal(request, &action)
+
+		mutex.Lock()
+		*requests = append(*requests, action.Hashes)
+		mutex.Unlock()
+
+		blocks := make([]string, 0)
+		notFound := make([]string, 0)
+		for _, hash := range action.Hashes {
+			if strings.HasPrefix(hash, "X") {
+				notFound = append(notFound, fmt.Sprintf("%q", hash))
+				continue
+			}
+			blocks = append(blocks, fmt.Sprintf(`%q: {"block_account": "nano_a", "height": "1", "successor": "0"}`, hash))
+		}
+
+		return []byte(fmt.Sprintf(`{"blocks": {%s}, "blocks_not_found": [%s]}`,
+			strings.Join(blocks, ","), strings.Join(notFound, ",")))
+	}, nil)
+}
+
+func TestBlocksInfoBatches(t *testing.T) {
+	client := mocks.IUSClient{}
+	requests := make([][]string, 0)
+	blocksInfoNode(&client, &requests)
+
+	var s = Server{usClient: &client, BlocksInfoBatch: 2}
+	stream := &blocksStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+
+	hashes := []string{"H1", "H2", "X3", "H4", "H5"}
+	err := s.BlocksInfo(&pb.BlocksInfoRequest{Hashes: hashes, IncludeNotFound: true}, stream)
+	require.Nil(t, err)
+
+	require.Equal(t, len(hashes), len(stream.replies))
+	for i, reply := range stream.replies {
+		assert.Equal(t, hashes[i], reply.BlockHash)
+	}
+	assert.True(t, stream.replies[2].NotFound)
+	assert.Nil(t, stream.replies[2].Block)
+	assert.False(t, stream.replies[3].NotFound)
+	assert.Equal(t, "nano_a", stream.replies[3].Block.BlockAccount)
+
+	assert.ElementsMatch(t, [][]string{{"H1", "H2"}, {"X3", "H4"}, {"H5"}}, requests)
+}
+
+func TestBlocksInfoRequest(t *testing.T) {
+	client := mocks.IUSClient{}
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"blocks": {"H1": {}}}`), nil)
+
+	var s = Server{usClient: &client}
+	stream := &blocksStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+
+	require.Nil(t, s.BlocksInfo(&pb.BlocksInfoRequest{Hashes: []string{"H1"}}, stream))
+
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t,
+		`{"action":"blocks_info", "hashes":["H1"], "json_block":"true", "include_not_found":"false"}`))
+}
+
+func TestBlocksInfoNotFound(t *testing.T) {
+	client := mocks.IUSClient{}
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"error": "Block not found"}`), nil)
+
+	var s = Server{usClient: &client}
+	stream := &blocksStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+
+	err := s.BlocksInfo(&pb.BlocksInfoRequest{Hashes: []string{"X1"}}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 0, len(stream.replies))
+}
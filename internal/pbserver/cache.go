@@ -0,0 +1,659 @@
+package pbserver
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"expvar"
+	"github.com/alvistar/nanopb/internal/nwsclient"
+	"github.com/alvistar/nanopb/internal/usclient"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Counters published on /debug/vars
+var (
+	cacheHits   = expvar.NewMap("cache_hits")
+	cacheMisses = expvar.NewMap("cache_misses")
+)
+
+// ConfCache configures the cache of the node replies
+type ConfCache struct {
+	// Milliseconds the reply of each action is cached. DefaultCacheTTL if nil.
+	TTL map[string]int `json:"ttl"`
+	// Confirmed blocks kept for block_info and blocks_info. Default is 10000.
+	Blocks int `json:"blocks"`
+}
+
+// DefaultCacheTTL caches the account data, which is invalidated by the
+// confirmations, and the slow-changing node data
+var DefaultCacheTTL = map[string]int{
+	"account_balance":   30000,
+	"accounts_balances": 30000,
+	"account_info":      30000,
+	"accounts_pending":  30000,
+	"block_count":       1000,
+	"version":           60000,
+}
+
+// accountActions reply with data changed by the blocks of their accounts.
+// They are cached only while the confirmations can invalidate them.
+var accountActions = map[string]bool{
+	"account_balance":   true,
+	"accounts_balances": true,
+	"account_info":      true,
+	"accounts_pending":  true,
+}
+
+// writeActions invalidate the entries of their accounts once they succeed,
+// before the confirmation of their block
+var writeActions = map[string]bool{
+	"send":    true,
+	"receive": true,
+}
+
+const cacheBuffer = 10000
+
+// confirmations is the part of nwsclient.WSClient followed by the cache
+type confirmations interface {
+	Connected() bool
+	SubscribeFilter(channel *chan pb.SubscriptionEntry, filter nwsclient.Filter,
+		policy pb.OverflowPolicy) (*nwsclient.Subscription, error)
+	SetFilter(channel *chan pb.SubscriptionEntry, filter nwsclient.Filter)
+	Unsubscribe(channel *chan pb.SubscriptionEntry)
+}
+
+// watch is an account whose confirmations are followed until expires
+type watch struct {
+	expires time.Time
+	// The subscription includes the account
+	followed bool
+}
+
+type cacheEntry struct {
+	reply    []byte
+	expires  time.Time
+	accounts []string
+}
+
+type cachedBlock struct {
+	hash string
+	info json.RawMessage
+}
+
+// flight is a request to the node shared by the concurrent callers of the same key
+type flight struct {
+	done     chan struct{}
+	reply    []byte
+	err      error
+	accounts []string
+	// Invalidated while in flight, the reply is not cached
+	stale bool
+}
+
+// responseCache sits between the handlers and the node. Replies are kept for
+// the TTL of their action, and confirmed blocks, which never change, until
+// evicted by newer ones.
+type responseCache struct {
+	client    usclient.IUSClient
+	ttl       map[string]time.Duration
+	maxTTL    time.Duration
+	maxBlocks int
+	// Confirmations of the watched accounts, see follow
+	ws        confirmations
+	ch        chan pb.SubscriptionEntry
+	following int32
+	// Serializes the updates of the subscription
+	subscribeMutex sync.Mutex
+	subscribed     bool
+
+	mutex     sync.Mutex
+	watched   map[string]*watch
+	entries   map[string]*cacheEntry
+	blocks    map[string]*list.Element
+	lru       *list.List
+	flights   map[string]*flight
+	nextPurge time.Time
+}
+
+func newResponseCache(client usclient.IUSClient, conf *ConfCache) *responseCache {
+	c := responseCache{
+		client:    client,
+		ttl:       map[string]time.Duration{},
+		maxBlocks: conf.Blocks,
+		ch:        make(chan pb.SubscriptionEntry, cacheBuffer),
+		watched:   map[string]*watch{},
+		entries:   map[string]*cacheEntry{},
+		blocks:    map[string]*list.Element{},
+		lru:       list.New(),
+		flights:   map[string]*flight{},
+	}
+
+	ttl := conf.TTL
+	if ttl == nil {
+		ttl = DefaultCacheTTL
+	}
+	for action, ms := range ttl {
+		if ms > 0 {
+			c.ttl[action] = time.Duration(ms) * time.Millisecond
+			if c.ttl[action] > c.maxTTL {
+				c.maxTTL = c.ttl[action]
+			}
+		}
+	}
+
+	if c.maxBlocks <= 0 {
+		c.maxBlocks = 10000
+	}
+
+	return &c
+}
+
+// get returns the reply to a JSON request, from the cache when possible
+func (c *responseCache) get(ctx context.Context, request string) ([]byte, error) {
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(request), &fields); err != nil {
+		return c.client.Get(ctx, []byte(request))
+	}
+
+	action, _ := fields["action"].(string)
+
+	_, hashes := fields["hashes"].([]interface{})
+	hash, _ := fields["hash"].(string)
+
+	switch {
+	case action == "block_info" && hash != "" && blockRequest(fields, "hash"):
+		return c.blockInfo(ctx, request, hash)
+	case action == "blocks_info" && hashes && blockRequest(fields, "hashes"):
+		return c.blocksInfo(ctx, request, fields)
+	case writeActions[action]:
+		return c.write(ctx, request, requestAccounts(fields))
+	}
+
+	ttl := c.ttl[action]
+	if ttl == 0 {
+		return c.client.Get(ctx, []byte(request))
+	}
+
+	accounts := requestAccounts(fields)
+	if accountActions[action] {
+		if !c.watch(accounts, ttl) {
+			return c.client.Get(ctx, []byte(request))
+		}
+		// A secondary node may lag behind the confirmations invalidating the entry
+		ctx = usclient.WithPrimary(ctx)
+	}
+
+	c.mutex.Lock()
+	entry, ok := c.entries[request]
+	if ok && time.Now().Before(entry.expires) {
+		c.mutex.Unlock()
+		cacheHits.Add(action, 1)
+		return entry.reply, nil
+	}
+	c.mutex.Unlock()
+
+	cacheMisses.Add(action, 1)
+
+	unwatched := false
+	reply, err := c.fetch(ctx, request, accounts, func(reply []byte) {
+		now := time.Now()
+		c.entries[request] = &cacheEntry{reply: reply, expires: now.Add(ttl), accounts: accounts}
+
+		// The accounts stay followed as long as the entry
+		for _, account := range accounts {
+			if w, ok := c.watched[account]; ok && w.expires.Before(now.Add(ttl)) {
+				w.expires = now.Add(ttl)
+			}
+		}
+
+		if now.After(c.nextPurge) {
+			unwatched = c.purge(now)
+			c.nextPurge = now.Add(c.maxTTL)
+		}
+	})
+
+	if unwatched {
+		c.subscribe()
+	}
+
+	return reply, err
+}
+
+// watch follows the confirmations of accounts for at least ttl, and reports
+// whether a reply about them can be cached: the confirmation stream is
+// connected, and the node was asked for the confirmations of the accounts
+// before this request. The first request of an account is not cached.
+func (c *responseCache) watch(accounts []string, ttl time.Duration) bool {
+	if c.ws == nil {
+		return false
+	}
+
+	now := time.Now()
+	followed := true
+	added := false
+
+	c.mutex.Lock()
+	for _, account := range accounts {
+		w, ok := c.watched[account]
+		if !ok {
+			w = &watch{}
+			c.watched[account] = w
+			added = true
+		}
+		if w.expires.Before(now.Add(ttl)) {
+			w.expires = now.Add(ttl)
+		}
+		followed = followed && w.followed
+	}
+	c.mutex.Unlock()
+
+	if added {
+		c.subscribe()
+	}
+
+	return followed && atomic.LoadInt32(&c.following) == 1
+}
+
+// subscribe updates the subscription to the watched accounts, unsubscribing
+// when there are none
+func (c *responseCache) subscribe() {
+	c.subscribeMutex.Lock()
+	defer c.subscribeMutex.Unlock()
+
+	c.mutex.Lock()
+	accounts := make([]string, 0, len(c.watched))
+	for account := range c.watched {
+		accounts = append(accounts, account)
+	}
+	c.mutex.Unlock()
+
+	filter := nwsclient.Filter{Accounts: accounts, Match: pb.AccountMatch_EITHER}
+
+	switch {
+	case len(accounts) == 0:
+		if c.subscribed {
+			c.ws.Unsubscribe(&c.ch)
+			c.subscribed = false
+			atomic.StoreInt32(&c.following, 0)
+		}
+		return
+	case !c.subscribed:
+		if _, err := c.ws.SubscribeFilter(&c.ch, filter, pb.OverflowPolicy_DROP_OLDEST); err != nil {
+			logger.Error("following the cached accounts: ", err)
+			return
+		}
+		c.subscribed = true
+
+		var connected int32
+		if c.ws.Connected() {
+			connected = 1
+		}
+		atomic.StoreInt32(&c.following, connected)
+	default:
+		c.ws.SetFilter(&c.ch, filter)
+	}
+
+	c.mutex.Lock()
+	for _, account := range accounts {
+		if w, ok := c.watched[account]; ok {
+			w.followed = true
+		}
+	}
+	c.mutex.Unlock()
+}
+
+// blockRequest reports whether a block_info or blocks_info request asks for
+// nothing more than the blocks in JSON, so the cached blocks can answer it
+func blockRequest(fields map[string]interface{}, hashes string) bool {
+	if fields["json_block"] != "true" {
+		return false
+	}
+
+	for field := range fields {
+		switch field {
+		case "action", "json_block", hashes, "include_not_found":
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestAccounts returns the accounts a request is about
+func requestAccounts(fields map[string]interface{}) []string {
+	accounts := make([]string, 0)
+
+	for _, field := range []string{"account", "source", "destination"} {
+		// The source option of accounts_pending is a flag
+		if field == "source" && fields["action"] == "accounts_pending" {
+			continue
+		}
+		if account, ok := fields[field].(string); ok {
+			accounts = append(accounts, account)
+		}
+	}
+
+	items, _ := fields["accounts"].([]interface{})
+	for _, item := range items {
+		if account, ok := item.(string); ok {
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts
+}
+
+// fetch sends request to the node, sharing the reply with the concurrent
+// callers of the same request. store is called with mutex held to cache a reply
+// that is not a node error and was not invalidated in flight.
+func (c *responseCache) fetch(ctx context.Context, request string, accounts []string,
+	store func(reply []byte)) ([]byte, error) {
+	c.mutex.Lock()
+
+	if f, ok := c.flights[request]; ok {
+		c.mutex.Unlock()
+
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, &nanoipc.Error{Code: 1, Message: ctx.Err().Error(), Category: "Context"}
+		}
+
+		if ipcErr, ok := f.err.(*nanoipc.Error); ok && ipcErr.Category == "Context" {
+			// The caller sending the request gave up, this one may not
+			return c.client.Get(ctx, []byte(request))
+		}
+		return f.reply, f.err
+	}
+
+	f := &flight{done: make(chan struct{}), accounts: accounts}
+	c.flights[request] = f
+	c.mutex.Unlock()
+
+	f.reply, f.err = c.client.Get(ctx, []byte(request))
+
+	c.mutex.Lock()
+	delete(c.flights, request)
+	if f.err == nil && !f.stale && !errorReply(f.reply) {
+		store(f.reply)
+	}
+	c.mutex.Unlock()
+
+	close(f.done)
+
+	return f.reply, f.err
+}
+
+// errorReply reports whether the node replied with an error
+func errorReply(reply []byte) bool {
+	fields := struct {
+		Error *string `json:"error"`
+	}{}
+	return json.Unmarshal(reply, &fields) != nil || fields.Error != nil
+}
+
+// confirmed reports whether a block info is confirmed, so it never changes
+func confirmed(info []byte) bool {
+	fields := struct {
+		Confirmed string `json:"confirmed"`
+	}{}
+	return json.Unmarshal(info, &fields) == nil && fields.Confirmed == "true"
+}
+
+// block returns a cached block info, marking it as recently used
+func (c *responseCache) block(hash string) (json.RawMessage, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.blocks[hash]
+	if !ok {
+		return nil, false
+	}
+
+	c.lru.MoveToFront(element)
+	return element.Value.(*cachedBlock).info, true
+}
+
+// storeBlock caches a confirmed block info, evicting the least recently used
+// ones beyond maxBlocks. Must be called with mutex held.
+func (c *responseCache) storeBlock(hash string, info json.RawMessage) {
+	if !confirmed(info) {
+		return
+	}
+
+	if element, ok := c.blocks[hash]; ok {
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.blocks[hash] = c.lru.PushFront(&cachedBlock{hash: hash, info: info})
+
+	for c.lru.Len() > c.maxBlocks {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.blocks, oldest.Value.(*cachedBlock).hash)
+	}
+}
+
+func (c *responseCache) blockInfo(ctx context.Context, request string, hash string) ([]byte, error) {
+	if info, ok := c.block(hash); ok {
+		cacheHits.Add("block_info", 1)
+		return info, nil
+	}
+
+	cacheMisses.Add("block_info", 1)
+
+	return c.fetch(ctx, request, nil, func(reply []byte) {
+		c.storeBlock(hash, reply)
+	})
+}
+
+// blocksInfo requests from the node the blocks missing from the cache only,
+// and merges the cached ones into its reply
+func (c *responseCache) blocksInfo(ctx context.Context, request string, fields map[string]interface{}) ([]byte, error) {
+	hashes, _ := fields["hashes"].([]interface{})
+
+	blocks := map[string]json.RawMessage{}
+	missing := make([]interface{}, 0)
+
+	for _, item := range hashes {
+		hash, _ := item.(string)
+		if info, ok := c.block(hash); ok {
+			blocks[hash] = info
+		} else {
+			missing = append(missing, item)
+		}
+	}
+
+	cacheHits.Add("blocks_info", int64(len(hashes)-len(missing)))
+	cacheMisses.Add("blocks_info", int64(len(missing)))
+
+	if len(missing) == len(hashes) {
+		reply, err := c.client.Get(ctx, []byte(request))
+		if err == nil {
+			c.storeBlocks(reply)
+		}
+		return reply, err
+	}
+
+	if len(missing) == 0 {
+		return json.Marshal(map[string]interface{}{"blocks": blocks})
+	}
+
+	fields["hashes"] = missing
+	missingRequest, _ := json.Marshal(fields)
+
+	reply, err := c.client.Get(ctx, missingRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched := map[string]json.RawMessage{}
+	if errorReply(reply) || json.Unmarshal(reply, &fetched) != nil {
+		return reply, nil
+	}
+
+	c.storeBlocks(reply)
+
+	fetchedBlocks := map[string]json.RawMessage{}
+	_ = json.Unmarshal(fetched["blocks"], &fetchedBlocks)
+	for hash, info := range fetchedBlocks {
+		blocks[hash] = info
+	}
+
+	merged := map[string]interface{}{"blocks": blocks}
+	if notFound, ok := fetched["blocks_not_found"]; ok {
+		merged["blocks_not_found"] = notFound
+	}
+
+	return json.Marshal(merged)
+}
+
+// storeBlocks caches the confirmed blocks of a blocks_info reply
+func (c *responseCache) storeBlocks(reply []byte) {
+	fields := struct {
+		Blocks map[string]json.RawMessage `json:"blocks"`
+	}{}
+	if errorReply(reply) || json.Unmarshal(reply, &fields) != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for hash, info := range fields.Blocks {
+		c.storeBlock(hash, info)
+	}
+}
+
+// write sends a request changing the balances of accounts, which are
+// invalidated once it succeeds
+func (c *responseCache) write(ctx context.Context, request string, accounts []string) ([]byte, error) {
+	reply, err := c.client.Get(ctx, []byte(request))
+	if err == nil && !errorReply(reply) {
+		c.invalidate(accounts...)
+	}
+	return reply, err
+}
+
+// invalidate removes the entries about accounts, including the ones in flight
+func (c *responseCache) invalidate(accounts ...string) {
+	touched := map[string]bool{}
+	for _, account := range accounts {
+		if account != "" {
+			touched[account] = true
+		}
+	}
+
+	if len(touched) == 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, entry := range c.entries {
+		if touches(entry.accounts, touched) {
+			delete(c.entries, key)
+		}
+	}
+
+	for _, f := range c.flights {
+		if touches(f.accounts, touched) {
+			f.stale = true
+		}
+	}
+}
+
+func touches(accounts []string, touched map[string]bool) bool {
+	for _, account := range accounts {
+		if touched[account] {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidateAccounts removes every entry about accounts, when confirmations
+// may have been missed
+func (c *responseCache) invalidateAccounts() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, entry := range c.entries {
+		if len(entry.accounts) > 0 {
+			delete(c.entries, key)
+		}
+	}
+
+	for _, f := range c.flights {
+		if len(f.accounts) > 0 {
+			f.stale = true
+		}
+	}
+}
+
+// purge removes the expired entries and watches, reporting whether accounts
+// are no longer watched. Must be called with mutex held.
+func (c *responseCache) purge(now time.Time) bool {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+
+	unwatched := map[string]bool{}
+	for account, w := range c.watched {
+		if !now.Before(w.expires) {
+			delete(c.watched, account)
+			unwatched[account] = true
+		}
+	}
+
+	// Without the confirmations of their accounts the entries may go stale
+	for key, entry := range c.entries {
+		if touches(entry.accounts, unwatched) {
+			delete(c.entries, key)
+		}
+	}
+
+	return len(unwatched) > 0
+}
+
+// follow invalidates the entries of the accounts of every confirmed block. Only
+// the confirmations of the watched accounts are requested. While the stream is
+// disconnected or drops confirmations, the entries about accounts are neither
+// served nor cached.
+func (c *responseCache) follow(ws confirmations) {
+	c.ws = ws
+	go c.invalidations()
+}
+
+func (c *responseCache) invalidations() {
+	var dropped uint64
+
+	for entry := range c.ch {
+		if entry.Topic == "status" {
+			if entry.Status == pb.ConnectionStatus_CONNECTED {
+				atomic.StoreInt32(&c.following, 1)
+			} else {
+				atomic.StoreInt32(&c.following, 0)
+				c.invalidateAccounts()
+			}
+			continue
+		}
+
+		// The count restarts with each subscription
+		if entry.Dropped != dropped {
+			dropped = entry.Dropped
+			c.invalidateAccounts()
+		}
+
+		c.invalidate(entry.GetMessage().GetAccount(), entry.GetMessage().GetBlock().GetLinkAsAccount())
+	}
+}
@@ -0,0 +1,350 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alvistar/nanopb/internal/nwsclient"
+	"github.com/alvistar/nanopb/internal/usclient"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeConfirmations records the accounts followed by the cache
+type fakeConfirmations struct {
+	mutex    sync.Mutex
+	accounts []string
+}
+
+func (f *fakeConfirmations) Connected() bool {
+	return true
+}
+
+func (f *fakeConfirmations) SubscribeFilter(channel *chan pb.SubscriptionEntry, filter nwsclient.Filter,
+	policy pb.OverflowPolicy) (*nwsclient.Subscription, error) {
+	f.SetFilter(channel, filter)
+	return nil, nil
+}
+
+func (f *fakeConfirmations) SetFilter(channel *chan pb.SubscriptionEntry, filter nwsclient.Filter) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.accounts = append([]string{}, filter.Accounts...)
+	sort.Strings(f.accounts)
+}
+
+func (f *fakeConfirmations) Unsubscribe(channel *chan pb.SubscriptionEntry) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.accounts = nil
+}
+
+func (f *fakeConfirmations) followed() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.accounts
+}
+
+// cachedServer returns a server caching the replies of client, following the
+// confirmations of nano_a
+func cachedServer(client *mocks.IUSClient) *Server {
+	s := Server{usClient: client}
+	s.cache = newResponseCache(client, &ConfCache{Blocks: 2})
+	s.cache.follow(&fakeConfirmations{})
+	s.cache.watch([]string{"nano_a"}, time.Hour)
+	return &s
+}
+
+func TestCacheBlockInfo(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, sameJSON(`{"action": "block_info", "hash": "C1", "json_block": "true"}`)).
+		Return([]byte(`{"block_account": "nano_a", "height": "1", "confirmed": "true"}`), nil)
+	client.On("Get", mock.Anything, sameJSON(`{"action": "block_info", "hash": "U1", "json_block": "true"}`)).
+		Return([]byte(`{"block_account": "nano_a", "height": "2", "confirmed": "false"}`), nil)
+
+	s := cachedServer(&client)
+
+	for i := 0; i < 3; i++ {
+		reply, err := s.BlockInfo(context.Background(), &pb.BlockInfoRequest{Hash: "C1"})
+		require.Nil(t, err)
+		assert.Equal(t, "1", reply.Height)
+
+		reply, err = s.BlockInfo(context.Background(), &pb.BlockInfoRequest{Hash: "U1"})
+		require.Nil(t, err)
+		assert.Equal(t, "2", reply.Height)
+	}
+
+	// Unconfirmed blocks may still be rolled back
+	client.AssertNumberOfCalls(t, "Get", 4)
+}
+
+func TestCacheBlocksLRU(t *testing.T) {
+	c := newResponseCache(&mocks.IUSClient{}, &ConfCache{Blocks: 2})
+	info := json.RawMessage(`{"confirmed": "true"}`)
+
+	c.mutex.Lock()
+	c.storeBlock("H1", info)
+	c.storeBlock("H2", info)
+	c.mutex.Unlock()
+
+	_, ok := c.block("H1")
+	assert.True(t, ok)
+
+	c.mutex.Lock()
+	c.storeBlock("H3", info)
+	c.mutex.Unlock()
+
+	_, ok = c.block("H2")
+	assert.False(t, ok)
+	_, ok = c.block("H1")
+	assert.True(t, ok)
+	_, ok = c.block("H3")
+	assert.True(t, ok)
+}
+
+func TestCacheBlocksInfo(t *testing.T) {
+	client := mocks.IUSClient{}
+	requests := make([][]string, 0)
+
+	// Blocks starting with "C" are confirmed, the ones starting with "X" not found
+	client.On("Get", mock.Anything, mock.Anything).Return(func(ctx context.Context, request []byte) []byte {
+		action := struct {
+			Hashes []string `json:"hashes"`
+		}{}
+		_ = json.Unmarshal(request, &action)
+		requests = append(requests, action.Hashes)
+
+		blocks := make([]string, 0)
+		notFound := make([]string, 0)
+		for _, hash := range action.Hashes {
+			if strings.HasPrefix(hash, "X") {
+				notFound = append(notFound, fmt.Sprintf("%q", hash))
+				continue
+			}
+			blocks = append(blocks, fmt.Sprintf(`%q: {"block_account": "nano_a", "confirmed": "%t"}`,
+				hash, strings.HasPrefix(hash, "C")))
+		}
+
+		return []byte(fmt.Sprintf(`{"blocks": {%s}, "blocks_not_found": [%s]}`,
+			strings.Join(blocks, ","), strings.Join(notFound, ",")))
+	}, nil)
+
+	s := cachedServer(&client)
+
+	hashes := []string{"C1", "U2", "X3"}
+	for i := 0; i < 2; i++ {
+		stream := &blocksStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+		err := s.BlocksInfo(&pb.BlocksInfoRequest{Hashes: hashes, IncludeNotFound: true}, stream)
+		require.Nil(t, err)
+
+		require.Equal(t, len(hashes), len(stream.replies))
+		for i, reply := range stream.replies {
+			assert.Equal(t, hashes[i], reply.BlockHash)
+		}
+		assert.Equal(t, "nano_a", stream.replies[0].Block.BlockAccount)
+		assert.Equal(t, "nano_a", stream.replies[1].Block.BlockAccount)
+		assert.True(t, stream.replies[2].NotFound)
+	}
+
+	assert.Equal(t, [][]string{{"C1", "U2", "X3"}, {"U2", "X3"}}, requests)
+
+	// Served by the block_info requests too
+	reply, err := s.BlockInfo(context.Background(), &pb.BlockInfoRequest{Hash: "C1"})
+	require.Nil(t, err)
+	assert.Equal(t, "nano_a", reply.BlockAccount)
+	client.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestCacheBalance(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, actionRequest("account_balance")).
+		Return([]byte(`{"balance": "1000", "pending": "0"}`), nil)
+	client.On("Get", mock.Anything, actionRequest("send")).
+		Return([]byte(`{"block": "B1"}`), nil)
+
+	s := cachedServer(&client)
+
+	balance := func() {
+		reply, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_a"})
+		require.Nil(t, err)
+		assert.Equal(t, "1000", reply.Balance)
+	}
+
+	balance()
+	balance()
+	client.AssertNumberOfCalls(t, "Get", 1)
+
+	// A confirmation of another account leaves the entry
+	s.cache.invalidate("nano_b")
+	balance()
+	client.AssertNumberOfCalls(t, "Get", 1)
+
+	// A confirmation sending to the account invalidates it
+	s.cache.invalidate("nano_b", "nano_a")
+	balance()
+	client.AssertNumberOfCalls(t, "Get", 2)
+
+	// So does a send of the gateway
+	_, err := s.Send(context.Background(), &pb.SendRequest{Wallet: "W1", Source: "nano_a", Destination: "nano_b", Amount: "1"})
+	require.Nil(t, err)
+	balance()
+	client.AssertNumberOfCalls(t, "Get", 4)
+
+	// Without the confirmations nothing is cached
+	s.cache.following = 0
+	balance()
+	balance()
+	client.AssertNumberOfCalls(t, "Get", 6)
+}
+
+func TestCacheAccountsPending(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, actionRequest("accounts_pending")).
+		Return([]byte(`{"blocks": {"nano_a": {"H1": {"amount": "10", "source": "nano_b"}}}}`), nil)
+
+	s := cachedServer(&client)
+
+	pending := func() {
+		reply, err := s.AccountsPending(context.Background(), &pb.AccountsPendingRequest{Accounts: []string{"nano_a"}})
+		require.Nil(t, err)
+		assert.Equal(t, 1, len(reply.Blocks["nano_a"].Blocks))
+	}
+
+	pending()
+	pending()
+	client.AssertNumberOfCalls(t, "Get", 1)
+
+	// A block sent to the account is receivable once confirmed
+	s.cache.invalidate("nano_b", "nano_a")
+	pending()
+	client.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestCacheExpires(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"count": "1000", "unchecked": "0"}`), nil)
+
+	s := Server{usClient: &client}
+	s.cache = newResponseCache(&client, &ConfCache{TTL: map[string]int{"block_count": 50}})
+
+	for i := 0; i < 2; i++ {
+		_, err := s.call(context.Background(), `{"action":"block_count"}`)
+		require.Nil(t, err)
+	}
+	client.AssertNumberOfCalls(t, "Get", 1)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err := s.call(context.Background(), `{"action":"block_count"}`)
+	require.Nil(t, err)
+	client.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestCacheNodeError(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"error": "Account not found"}`), nil)
+
+	s := cachedServer(&client)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_a"})
+		assert.NotNil(t, err)
+	}
+	client.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestCacheSharedRequest(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).
+		After(50*time.Millisecond).
+		Return([]byte(`{"balance": "1000", "pending": "0"}`), nil)
+
+	s := cachedServer(&client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reply, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_a"})
+			assert.Nil(t, err)
+			assert.Equal(t, "1000", reply.GetBalance())
+		}()
+	}
+	wg.Wait()
+
+	client.AssertNumberOfCalls(t, "Get", 1)
+}
+
+func TestCacheFollowsCachedAccounts(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"balance": "1000", "pending": "0"}`), nil)
+
+	s := Server{usClient: &client}
+	s.cache = newResponseCache(&client, &ConfCache{})
+	ws := &fakeConfirmations{}
+	s.cache.follow(ws)
+
+	balance := func(account string) {
+		_, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: account})
+		require.Nil(t, err)
+	}
+
+	// The first request asks for the confirmations of the account, the next
+	// ones are cached
+	balance("nano_a")
+	assert.Equal(t, []string{"nano_a"}, ws.followed())
+	client.AssertNumberOfCalls(t, "Get", 1)
+
+	balance("nano_a")
+	balance("nano_a")
+	client.AssertNumberOfCalls(t, "Get", 2)
+
+	balance("nano_b")
+	assert.Equal(t, []string{"nano_a", "nano_b"}, ws.followed())
+
+	// Once the entries of an account expire it is no longer followed
+	s.cache.mutex.Lock()
+	s.cache.watched["nano_a"].expires = time.Now()
+	s.cache.nextPurge = time.Time{}
+	s.cache.mutex.Unlock()
+
+	balance("nano_b")
+	assert.Equal(t, []string{"nano_b"}, ws.followed())
+
+	balance("nano_a")
+	client.AssertNumberOfCalls(t, "Get", 5)
+}
+
+func TestCacheBalanceFlatbuffers(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, actionRequest("account_balance")).
+		Return([]byte(`{"balance": "1000", "pending": "0"}`), nil)
+
+	s := cachedServer(&client)
+	s.USConfig = &usclient.ConfNode{Encoding: usclient.EncodingFlatbuffers}
+
+	for i := 0; i < 2; i++ {
+		reply, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_a"})
+		require.Nil(t, err)
+		assert.Equal(t, "1000", reply.Balance)
+	}
+
+	client.AssertNumberOfCalls(t, "Get", 1)
+	client.AssertNotCalled(t, "GetMessage", mock.Anything, mock.Anything)
+}
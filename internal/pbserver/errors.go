@@ -0,0 +1,106 @@
+package pbserver
+
+import (
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// nodeErrors maps the error messages of the node to gRPC codes
+var nodeErrors = map[string]codes.Code{
+	"Account not found":                       codes.NotFound,
+	"Account not found in wallet":             codes.NotFound,
+	"Block not found":                         codes.NotFound,
+	"Wallet not found":                        codes.NotFound,
+	"Bad account number":                      codes.InvalidArgument,
+	"Bad amount number":                       codes.InvalidArgument,
+	"Bad balance number":                      codes.InvalidArgument,
+	"Bad block hash":                          codes.InvalidArgument,
+	"Bad destination account":                 codes.InvalidArgument,
+	"Bad source account":                      codes.InvalidArgument,
+	"Bad wallet number":                       codes.InvalidArgument,
+	"Invalid block hash":                      codes.InvalidArgument,
+	"Insufficient balance":                    codes.FailedPrecondition,
+	"Wallet is locked":                        codes.FailedPrecondition,
+	"Account already exists":                  codes.AlreadyExists,
+	"Unknown command":                         codes.Unimplemented,
+	"RPC control is disabled":                 codes.PermissionDenied,
+	"Unable to parse JSON":                    codes.Internal,
+	"Empty response":                          codes.Internal,
+	"Work generation cancellation or failure": codes.Unavailable,
+}
+
+// categoryCodes maps the categories of nanoipc errors to gRPC codes
+var categoryCodes = map[string]codes.Code{
+	"Connection": codes.Unavailable,
+	"Network":    codes.Unavailable,
+	"Context":    codes.Canceled,
+	// Every IPC session stayed busy
+	"Pool": codes.Unavailable,
+	// The circuit breaker of the node is open
+	"Breaker": codes.Unavailable,
+}
+
+// nodeErrorCode returns the gRPC code of a node error message. Messages
+// missing from nodeErrors are matched on their wording.
+func nodeErrorCode(message string) codes.Code {
+	if code, ok := nodeErrors[message]; ok {
+		return code
+	}
+
+	lower := strings.ToLower(message)
+
+	switch {
+	case strings.HasPrefix(lower, "bad ") || strings.HasPrefix(lower, "invalid "):
+		return codes.InvalidArgument
+	case strings.HasSuffix(lower, " not found"):
+		return codes.NotFound
+	}
+
+	return codes.Unknown
+}
+
+// errorStatus returns a gRPC status error carrying the original error as detail
+func errorStatus(code codes.Code, message string, original string) error {
+	st := status.New(code, message)
+
+	if detailed, err := st.WithDetails(&errdetails.DebugInfo{Detail: original}); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+// nodeError converts an error message of the node into a gRPC status
+func nodeError(message string) error {
+	return errorStatus(nodeErrorCode(message), message, message)
+}
+
+// ipcError converts an error of the node connection into a gRPC status
+func ipcError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	ipcErr, ok := err.(*nanoipc.Error)
+	if !ok {
+		return errorStatus(codes.Unknown, err.Error(), err.Error())
+	}
+
+	if ipcErr.Category == "Node" {
+		return nodeError(ipcErr.Message)
+	}
+
+	code, ok := categoryCodes[ipcErr.Category]
+	if !ok {
+		code = codes.Internal
+	}
+
+	if ipcErr.Category == "Context" && strings.Contains(ipcErr.Message, "deadline") {
+		code = codes.DeadlineExceeded
+	}
+
+	return errorStatus(code, ipcErr.Message, ipcErr.Error())
+}
@@ -0,0 +1,80 @@
+package pbserver
+
+import (
+	"context"
+	"errors"
+	"github.com/alvistar/nanopb/internal/usclient"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestNodeErrorCode(t *testing.T) {
+	assert.Equal(t, codes.NotFound, nodeErrorCode("Account not found"))
+	assert.Equal(t, codes.InvalidArgument, nodeErrorCode("Bad account number"))
+	assert.Equal(t, codes.FailedPrecondition, nodeErrorCode("Insufficient balance"))
+	assert.Equal(t, codes.InvalidArgument, nodeErrorCode("Bad representative account"))
+	assert.Equal(t, codes.NotFound, nodeErrorCode("Pending not found"))
+	assert.Equal(t, codes.Unknown, nodeErrorCode("myerror"))
+}
+
+func TestIPCError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{&nanoipc.Error{Code: 1, Message: "broken pipe", Category: "Network"}, codes.Unavailable},
+		{&nanoipc.Error{Code: 1, Message: "Invalid connection string", Category: "Connection"}, codes.Unavailable},
+		{&nanoipc.Error{Code: 1, Message: "circuit breaker open for local:///tmp/node", Category: "Breaker"}, codes.Unavailable},
+		{&nanoipc.Error{Code: 1, Message: "context canceled", Category: "Context"}, codes.Canceled},
+		{&nanoipc.Error{Code: 1, Message: "context deadline exceeded", Category: "Context"}, codes.DeadlineExceeded},
+		{&nanoipc.Error{Code: 2, Message: "Block not found", Category: "Node"}, codes.NotFound},
+		{&nanoipc.Error{Code: 1, Message: "Short flatbuffers reply"}, codes.Internal},
+		{errors.New("other"), codes.Unknown},
+		{status.Error(codes.Aborted, "aborted"), codes.Aborted},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.code, status.Code(ipcError(test.err)), test.err.Error())
+	}
+}
+
+func TestAccountBalanceNodeError(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"error":"Bad account number"}`), nil)
+	var s = Server{usClient: &client}
+
+	_, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_x"})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "Bad account number", st.Message())
+
+	require.Equal(t, 1, len(st.Details()))
+	assert.Equal(t, "Bad account number", st.Details()[0].(*errdetails.DebugInfo).Detail)
+}
+
+func TestAccountBalanceNetworkError(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("GetMessage", mock.Anything, mock.Anything).
+		Return(nil, &nanoipc.Error{Code: 1, Message: "Not connected", Category: "Network"})
+	var s = Server{
+		usClient: &client,
+		USConfig: &usclient.ConfNode{Encoding: usclient.EncodingFlatbuffers},
+	}
+
+	_, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_x"})
+	st := status.Convert(err)
+	assert.Equal(t, codes.Unavailable, st.Code())
+
+	require.Equal(t, 1, len(st.Details()))
+	assert.Equal(t, "1:Network:Not connected", st.Details()[0].(*errdetails.DebugInfo).Detail)
+}
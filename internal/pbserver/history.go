@@ -0,0 +1,160 @@
+package pbserver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+const defaultHistoryPage = 100
+
+// The node adds fields to the history entries over time, and raw ones vary
+// with the block type
+var historyUnmarshaler = jsonpb.Unmarshaler{AllowUnknownFields: true}
+
+// historyToken is the content of an AccountHistory continuation
+type historyToken struct {
+	Account string `json:"a"`
+	Reverse bool   `json:"r"`
+	Head    string `json:"h"`
+}
+
+func encodeHistoryToken(token historyToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeHistoryToken(continuation string) (historyToken, error) {
+	token := historyToken{}
+
+	data, err := base64.RawURLEncoding.DecodeString(continuation)
+	if err == nil {
+		err = json.Unmarshal(data, &token)
+	}
+	if err != nil || token.Head == "" {
+		return token, status.Error(codes.InvalidArgument, "invalid continuation")
+	}
+
+	return token, nil
+}
+
+// historyPage is a page of account_history
+type historyPage struct {
+	entries []*pb.AccountHistoryEntry
+	// Block following the page, empty at the end of the chain
+	next string
+}
+
+// fetchHistory requests count entries of the history starting from head
+func (server *Server) fetchHistory(stream pb.Nano_AccountHistoryServer, request *pb.AccountHistoryRequest,
+	head string, offset uint64, count uint64) (*historyPage, error) {
+	action := map[string]interface{}{
+		"action":  "account_history",
+		"account": request.Account,
+		"count":   strconv.FormatUint(count, 10),
+		"raw":     strconv.FormatBool(request.Raw),
+		"reverse": strconv.FormatBool(request.Reverse),
+	}
+
+	if head != "" {
+		action["head"] = head
+	}
+	if offset > 0 {
+		action["offset"] = strconv.FormatUint(offset, 10)
+	}
+	if len(request.AccountFilter) > 0 {
+		action["account_filter"] = request.AccountFilter
+	}
+
+	data, _ := json.Marshal(action)
+
+	reply, err := server.call(stream.Context(), string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	page := historyPage{}
+
+	// The node returns an empty string instead of an empty history
+	for _, child := range reply.Path("history").Children() {
+		entry := pb.AccountHistoryEntry{}
+		if err := historyUnmarshaler.Unmarshal(bytes.NewReader(child.Bytes()), &entry); err != nil {
+			return nil, errorStatus(codes.Internal, "unexpected history entry from node", child.String())
+		}
+		page.entries = append(page.entries, &entry)
+	}
+
+	next := "previous"
+	if request.Reverse {
+		next = "next"
+	}
+	page.next, _ = reply.Path(next).Data().(string)
+
+	return &page, nil
+}
+
+func (server *Server) AccountHistory(request *pb.AccountHistoryRequest, stream pb.Nano_AccountHistoryServer) error {
+	head, offset := request.Head, request.Offset
+
+	if request.Continuation != "" {
+		token, err := decodeHistoryToken(request.Continuation)
+		if err != nil {
+			return err
+		}
+		if token.Account != request.Account || token.Reverse != request.Reverse {
+			return status.Error(codes.InvalidArgument, "continuation does not match the request")
+		}
+		head, offset = token.Head, 0
+	}
+
+	pageSize := uint64(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultHistoryPage
+	}
+
+	var sent uint64
+
+	for {
+		count := pageSize
+		if request.Count > 0 && request.Count-sent < count {
+			count = request.Count - sent
+		}
+
+		page, err := server.fetchHistory(stream, request, head, offset, count)
+		if err != nil {
+			return err
+		}
+
+		for i, entry := range page.entries {
+			next := page.next
+			if i+1 < len(page.entries) {
+				next = page.entries[i+1].Hash
+			}
+
+			if next != "" {
+				entry.Continuation = encodeHistoryToken(historyToken{
+					Account: request.Account,
+					Reverse: request.Reverse,
+					Head:    next,
+				})
+			}
+
+			if err := stream.Send(entry); err != nil {
+				return err
+			}
+		}
+
+		sent += uint64(len(page.entries))
+
+		if (request.Count > 0 && sent >= request.Count) || page.next == "" || page.next == head {
+			return nil
+		}
+
+		head, offset = page.next, 0
+	}
+}
@@ -0,0 +1,133 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type historyStream struct {
+	fakeServerStream
+	entries []*pb.AccountHistoryEntry
+}
+
+func (s *historyStream) Send(entry *pb.AccountHistoryEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func newHistoryStream() *historyStream {
+	return &historyStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+}
+
+// historyRequest matches the account_history requests starting from head
+func historyRequest(head string) interface{} {
+	return mock.MatchedBy(func(request []byte) bool {
+		action := map[string]interface{}{}
+		_ = json.Unmarshal(request, &action)
+		h, _ := action["head"].(string)
+		return action["action"] == "account_history" && h == head
+	})
+}
+
+func TestAccountHistoryPages(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, historyRequest("")).Return([]byte(`{
+		"account": "nano_a",
+		"history": [
+			{"type": "send", "account": "nano_b", "amount": "1", "hash": "H3", "height": "3", "confirmed": "true"},
+			{"type": "receive", "account": "nano_c", "amount": "2", "hash": "H2", "height": "2", "confirmed": "true"}
+		],
+		"previous": "H1"
+	}`), nil)
+	client.On("Get", mock.Anything, historyRequest("H1")).Return([]byte(`{
+		"account": "nano_a",
+		"history": [
+			{"type": "receive", "account": "nano_d", "amount": "3", "hash": "H1", "height": "1", "confirmed": "true",
+			 "opened": "nano_a", "work": "8a142e07a10996d5"}
+		]
+	}`), nil)
+
+	var s = Server{usClient: &client}
+	stream := newHistoryStream()
+
+	err := s.AccountHistory(&pb.AccountHistoryRequest{Account: "nano_a", PageSize: 2}, stream)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(stream.entries))
+	assert.Equal(t, []string{"H3", "H2", "H1"},
+		[]string{stream.entries[0].Hash, stream.entries[1].Hash, stream.entries[2].Hash})
+	assert.Equal(t, "8a142e07a10996d5", stream.entries[2].Work)
+	assert.Equal(t, "", stream.entries[2].Continuation)
+
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t,
+		`{"action":"account_history", "account":"nano_a", "count":"2", "raw":"false", "reverse":"false"}`))
+
+	// Resuming after the first entry starts from the second one
+	token, err := decodeHistoryToken(stream.entries[0].Continuation)
+	require.Nil(t, err)
+	assert.Equal(t, "H2", token.Head)
+
+	token, err = decodeHistoryToken(stream.entries[1].Continuation)
+	require.Nil(t, err)
+	assert.Equal(t, "H1", token.Head)
+
+	resumed := newHistoryStream()
+	err = s.AccountHistory(&pb.AccountHistoryRequest{
+		Account:      "nano_a",
+		Continuation: stream.entries[1].Continuation,
+	}, resumed)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resumed.entries))
+	assert.Equal(t, "H1", resumed.entries[0].Hash)
+}
+
+func TestAccountHistoryCount(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, historyRequest("")).Return([]byte(`{
+		"account": "nano_a",
+		"history": [{"type": "send", "hash": "H3"}],
+		"previous": "H2"
+	}`), nil)
+
+	var s = Server{usClient: &client}
+	stream := newHistoryStream()
+
+	err := s.AccountHistory(&pb.AccountHistoryRequest{Account: "nano_a", Count: 1, Reverse: false}, stream)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(stream.entries))
+	assert.NotEqual(t, "", stream.entries[0].Continuation)
+	client.AssertNumberOfCalls(t, "Get", 1)
+}
+
+func TestAccountHistoryEmpty(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"account": "nano_a", "history": ""}`), nil)
+
+	var s = Server{usClient: &client}
+	stream := newHistoryStream()
+
+	err := s.AccountHistory(&pb.AccountHistoryRequest{Account: "nano_a"}, stream)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(stream.entries))
+}
+
+func TestAccountHistoryInvalidContinuation(t *testing.T) {
+	var s = Server{}
+
+	err := s.AccountHistory(&pb.AccountHistoryRequest{Account: "nano_a", Continuation: "???"}, newHistoryStream())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	continuation := encodeHistoryToken(historyToken{Account: "nano_a", Reverse: true, Head: "H1"})
+	err = s.AccountHistory(&pb.AccountHistoryRequest{Account: "nano_a", Continuation: continuation}, newHistoryStream())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
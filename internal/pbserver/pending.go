@@ -0,0 +1,83 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// AccountsPending returns the receivable blocks of the accounts. The node
+// shapes the reply after the options, so source is always requested to get
+// amount and source of every block.
+func (server *Server) AccountsPending(ctx context.Context, pbRequest *pb.AccountsPendingRequest) (*pb.AccountsPendingReply, error) {
+	action := map[string]interface{}{
+		"action":   "accounts_pending",
+		"accounts": pbRequest.Accounts,
+		"source":   "true",
+	}
+
+	// Sent only when set, the node default differs between versions
+	if pbRequest.IncludeOnlyConfirmed {
+		action["include_only_confirmed"] = "true"
+	}
+
+	if pbRequest.Count > 0 {
+		action["count"] = strconv.FormatUint(pbRequest.Count, 10)
+	}
+
+	if pbRequest.Threshold != "" {
+		if amount, ok := new(big.Int).SetString(pbRequest.Threshold, 10); !ok || amount.Sign() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid threshold %q", pbRequest.Threshold)
+		}
+		action["threshold"] = pbRequest.Threshold
+	}
+
+	data, _ := json.Marshal(action)
+
+	reply, err := server.call(ctx, string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	pending := pb.AccountsPendingReply{Blocks: map[string]*pb.PendingBlocks{}}
+
+	// Accounts without receivable blocks have an empty string
+	for account, child := range reply.Path("blocks").ChildrenMap() {
+		blocks := pb.PendingBlocks{}
+
+		for hash, block := range child.ChildrenMap() {
+			amount, _ := block.Path("amount").Data().(string)
+			source, _ := block.Path("source").Data().(string)
+
+			blocks.Blocks = append(blocks.Blocks, &pb.PendingBlock{Hash: hash, Amount: amount, Source: source})
+		}
+
+		sortPending(blocks.Blocks)
+		pending.Blocks[account] = &blocks
+	}
+
+	return &pending, nil
+}
+
+// sortPending sorts the blocks by decreasing amount, then by hash
+func sortPending(blocks []*pb.PendingBlock) {
+	amount := func(block *pb.PendingBlock) *big.Int {
+		a, ok := new(big.Int).SetString(block.Amount, 10)
+		if !ok {
+			return new(big.Int)
+		}
+		return a
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		if c := amount(blocks[i]).Cmp(amount(blocks[j])); c != 0 {
+			return c > 0
+		}
+		return blocks[i].Hash < blocks[j].Hash
+	})
+}
@@ -0,0 +1,170 @@
+package pbserver
+
+import (
+	"context"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	pbv2 "github.com/alvistar/nanopb/nanoproto/v2"
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// Scopes granted by the scope claim of a token
+const (
+	// Queries and subscriptions
+	ScopeRead = "read"
+	// Calls moving funds or modifying the node wallet
+	ScopeWalletWrite = "wallet-write"
+	// Node actions forwarded by RawRequest
+	ScopeRaw = "raw"
+)
+
+// Claims restricting the wallets and source accounts a token may use in Send.
+// Any wallet or source is allowed when the claim is missing.
+const (
+	claimWallets = "wallets"
+	claimSources = "sources"
+)
+
+// A Policy maps gRPC full method names to the scope required to call them.
+// Methods missing from the policy are denied.
+type Policy map[string]string
+
+// DefaultPolicy allows every query with the read scope, requires the
+// wallet-write scope for the calls using the node wallet and the raw scope
+// for RawRequest
+var DefaultPolicy = Policy{
+	"/nanoproto.Nano/BlocksInfo":                    ScopeRead,
+	"/nanoproto.Nano/BlockInfo":                     ScopeRead,
+	"/nanoproto.Nano/Subscribe":                     ScopeRead,
+	"/nanoproto.Nano/AccountsBalances":              ScopeRead,
+	"/nanoproto.Nano/AccountBalance":                ScopeRead,
+	"/nanoproto.Nano/AccountInfo":                   ScopeRead,
+	"/nanoproto.Nano/AccountHistory":                ScopeRead,
+	"/nanoproto.Nano/AccountCreate":                 ScopeWalletWrite,
+	"/nanoproto.Nano/ValidateAccountNumber":         ScopeRead,
+	"/nanoproto.Nano/Send":                          ScopeWalletWrite,
+	"/nanoproto.Nano/AccountsPending":               ScopeRead,
+	"/nanoproto.Nano/Receive":                       ScopeWalletWrite,
+	"/nanoproto.Nano/RawRequest":                    ScopeRaw,
+	"/nanoproto.Nano/Status":                        ScopeRead,
+	"/nanoproto.Nano/SubscribeVotes":                ScopeRead,
+	"/nanoproto.Nano/SubscribeStoppedElections":     ScopeRead,
+	"/nanoproto.Nano/SubscribeActiveDifficulty":     ScopeRead,
+	"/nanoproto.Nano/SubscribeWork":                 ScopeRead,
+	"/nanoproto.Nano/SubscribeTelemetry":            ScopeRead,
+	"/nanoproto.Nano/SubscribeNewUnconfirmedBlocks": ScopeRead,
+	"/nanoproto.Nano/SubscribeBootstrap":            ScopeRead,
+	"/nanoproto.v2.Nano/BlocksInfo":                 ScopeRead,
+	"/nanoproto.v2.Nano/BlockInfo":                  ScopeRead,
+	"/nanoproto.v2.Nano/Subscribe":                  ScopeRead,
+	"/nanoproto.v2.Nano/AccountsBalances":           ScopeRead,
+	"/nanoproto.v2.Nano/AccountBalance":             ScopeRead,
+	"/nanoproto.v2.Nano/AccountCreate":              ScopeWalletWrite,
+	"/nanoproto.v2.Nano/ValidateAccountNumber":      ScopeRead,
+	"/nanoproto.v2.Nano/Send":                       ScopeWalletWrite,
+}
+
+func (server *Server) policy() Policy {
+	if server.Policy == nil {
+		return DefaultPolicy
+	}
+	return server.Policy
+}
+
+// claimList reads a claim holding either a space separated string or a list of strings
+func claimList(claims jwt.MapClaims, name string) (list []string, ok bool) {
+	switch value := claims[name].(type) {
+	case string:
+		return strings.Fields(value), true
+	case []interface{}:
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+// scopes returns the scopes granted by the scope or scopes claim
+func scopes(claims jwt.MapClaims) []string {
+	if list, ok := claimList(claims, "scope"); ok {
+		return list
+	}
+	list, _ := claimList(claims, "scopes")
+	return list
+}
+
+// checkSend verifies the wallet and source of a send against the claims of the token
+func checkSend(claims jwt.MapClaims, request *pb.SendRequest) error {
+	if wallets, ok := claimList(claims, claimWallets); ok && !stringInSlice(request.Wallet, wallets) {
+		return status.Errorf(codes.PermissionDenied, "wallet %s not allowed", request.Wallet)
+	}
+
+	if sources, ok := claimList(claims, claimSources); ok && !stringInSlice(request.Source, sources) {
+		return status.Errorf(codes.PermissionDenied, "source %s not allowed", request.Source)
+	}
+
+	return nil
+}
+
+// checkReceive verifies the wallet of a receive against the claims of the token
+func checkReceive(claims jwt.MapClaims, request *pb.ReceiveRequest) error {
+	if wallets, ok := claimList(claims, claimWallets); ok && !stringInSlice(request.Wallet, wallets) {
+		return status.Errorf(codes.PermissionDenied, "wallet %s not allowed", request.Wallet)
+	}
+
+	return nil
+}
+
+// checkRaw verifies the wallet and source of a raw request against the claims
+// of the token, as for the typed methods
+func checkRaw(claims jwt.MapClaims, action string, params map[string]interface{}) error {
+	wallet, _ := params["wallet"].(string)
+
+	switch action {
+	case "send":
+		source, _ := params["source"].(string)
+		return checkSend(claims, &pb.SendRequest{Wallet: wallet, Source: source})
+	case "receive":
+		return checkReceive(claims, &pb.ReceiveRequest{Wallet: wallet})
+	}
+
+	if _, ok := params["wallet"]; !ok {
+		return nil
+	}
+	if wallets, ok := claimList(claims, claimWallets); ok && !stringInSlice(wallet, wallets) {
+		return status.Errorf(codes.PermissionDenied, "wallet %s not allowed", wallet)
+	}
+
+	return nil
+}
+
+// authorize checks that the authenticated request may call method. req is the
+// request message of unary calls, nil for streams.
+func (server *Server) authorize(ctx context.Context, method string, req interface{}) error {
+	scope, ok := server.policy()[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "method %s not allowed", method)
+	}
+
+	claims := claimsFromContext(ctx)
+
+	if !stringInSlice(scope, scopes(claims)) {
+		return status.Errorf(codes.PermissionDenied, "scope %s required", scope)
+	}
+
+	switch send := req.(type) {
+	case *pb.SendRequest:
+		return checkSend(claims, send)
+	case *pbv2.SendRequest:
+		return checkSend(claims, &pb.SendRequest{Wallet: send.Wallet, Source: send.Source})
+	case *pb.ReceiveRequest:
+		return checkReceive(claims, send)
+	}
+
+	return nil
+}
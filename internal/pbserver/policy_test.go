@@ -0,0 +1,98 @@
+package pbserver
+
+import (
+	"context"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	pbv2 "github.com/alvistar/nanopb/nanoproto/v2"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+func withClaims(claims jwt.MapClaims) context.Context {
+	return context.WithValue(context.Background(), claimsKey{}, claims)
+}
+
+func TestDefaultPolicyCoversService(t *testing.T) {
+	services := map[string]reflect.Type{
+		"/nanoproto.Nano/":    reflect.TypeOf((*pb.NanoServer)(nil)).Elem(),
+		"/nanoproto.v2.Nano/": reflect.TypeOf((*pbv2.NanoServer)(nil)).Elem(),
+	}
+
+	for prefix, service := range services {
+		for i := 0; i < service.NumMethod(); i++ {
+			method := prefix + service.Method(i).Name
+			_, ok := DefaultPolicy[method]
+			assert.True(t, ok, method)
+		}
+	}
+}
+
+func TestAuthorizeScopes(t *testing.T) {
+	server := Server{}
+
+	read := withClaims(jwt.MapClaims{"scope": "read"})
+	assert.Nil(t, server.authorize(read, "/nanoproto.Nano/BlockInfo", nil))
+
+	err := server.authorize(read, "/nanoproto.Nano/AccountCreate", nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	write := withClaims(jwt.MapClaims{"scopes": []interface{}{"read", "wallet-write"}})
+	assert.Nil(t, server.authorize(write, "/nanoproto.Nano/AccountCreate", nil))
+
+	err = server.authorize(write, "/nanoproto.Nano/Unknown", nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = server.authorize(context.Background(), "/nanoproto.Nano/BlockInfo", nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthorizeSend(t *testing.T) {
+	server := Server{}
+
+	ctx := withClaims(jwt.MapClaims{
+		"scope":   "wallet-write",
+		"wallets": []interface{}{"W1"},
+		"sources": "nano_a nano_b",
+	})
+
+	assert.Nil(t, server.authorize(ctx, "/nanoproto.Nano/Send",
+		&pb.SendRequest{Wallet: "W1", Source: "nano_b"}))
+
+	err := server.authorize(ctx, "/nanoproto.Nano/Send", &pb.SendRequest{Wallet: "W2", Source: "nano_a"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = server.authorize(ctx, "/nanoproto.Nano/Send", &pb.SendRequest{Wallet: "W1", Source: "nano_c"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	unrestricted := withClaims(jwt.MapClaims{"scope": "wallet-write"})
+	assert.Nil(t, server.authorize(unrestricted, "/nanoproto.Nano/Send",
+		&pb.SendRequest{Wallet: "W2", Source: "nano_c"}))
+}
+
+func TestAuthorizeSendV2(t *testing.T) {
+	server := Server{}
+
+	ctx := withClaims(jwt.MapClaims{"scope": "wallet-write", "wallets": "W1"})
+
+	assert.Nil(t, server.authorize(ctx, "/nanoproto.v2.Nano/Send", &pbv2.SendRequest{Wallet: "W1"}))
+
+	err := server.authorize(ctx, "/nanoproto.v2.Nano/Send", &pbv2.SendRequest{Wallet: "W2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthorizeReceive(t *testing.T) {
+	server := Server{}
+
+	ctx := withClaims(jwt.MapClaims{"scope": "wallet-write", "wallets": "W1", "sources": "nano_a"})
+
+	// Sources restrict sends only
+	assert.Nil(t, server.authorize(ctx, "/nanoproto.Nano/Receive",
+		&pb.ReceiveRequest{Wallet: "W1", Account: "nano_b"}))
+
+	err := server.authorize(ctx, "/nanoproto.Nano/Receive", &pb.ReceiveRequest{Wallet: "W2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,137 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RawAllowAll in Server.RawAllow allows every action not denied
+const RawAllowAll = "*"
+
+// DefaultRawDeny lists the actions stopping or reconfiguring the node,
+// publishing blocks, destroying wallets, revealing private keys, or changing
+// wallets and spending their funds, which the typed methods cover with the
+// wallet-write scope
+var DefaultRawDeny = []string{
+	"stop",
+	"keepalive",
+	"work_peer_add",
+	"work_cancel",
+	"bootstrap",
+	"bootstrap_any",
+	"bootstrap_lazy",
+	"epoch_upgrade",
+	"populate_backlog",
+	"stats_clear",
+	"process",
+	"republish",
+	"wallet_republish",
+	"block_confirm",
+	"wallet_destroy",
+	"wallet_export",
+	"wallet_change_seed",
+	"wallet_add",
+	"password_change",
+	"deterministic_key",
+	"key_expand",
+	"node_id",
+	"node_id_delete",
+	"account_remove",
+	"account_move",
+	"unchecked_clear",
+	"work_peers_clear",
+	"send",
+	"receive",
+	"receive_minimum_set",
+	"account_create",
+	"accounts_create",
+	"wallet_create",
+	"wallet_add_watch",
+	"account_representative_set",
+	"wallet_representative_set",
+	"search_pending",
+	"search_pending_all",
+	"search_receivable",
+	"search_receivable_all",
+	"wallet_import",
+	"password_enter",
+	"wallet_lock",
+	"work_set",
+	"block_create",
+	"sign",
+}
+
+func (server *Server) rawDeny() []string {
+	if server.RawDeny == nil {
+		return DefaultRawDeny
+	}
+	return server.RawDeny
+}
+
+// rawAllowed reports whether RawRequest may forward action
+func (server *Server) rawAllowed(action string) bool {
+	if stringInSlice(action, server.rawDeny()) {
+		return false
+	}
+	return stringInSlice(RawAllowAll, server.RawAllow) || stringInSlice(action, server.RawAllow)
+}
+
+// RawRequest forwards an action with its JSON parameters to the node and
+// returns the reply as is
+func (server *Server) RawRequest(ctx context.Context, pbRequest *pb.RawActionRequest) (*pb.RawActionReply, error) {
+	if len(server.RawAllow) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "raw requests not enabled")
+	}
+
+	if pbRequest.Action == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing action")
+	}
+
+	if !server.rawAllowed(pbRequest.Action) {
+		return nil, status.Errorf(codes.PermissionDenied, "action %s not allowed", pbRequest.Action)
+	}
+
+	params := map[string]interface{}{}
+	if pbRequest.Json != "" {
+		if err := json.Unmarshal([]byte(pbRequest.Json), &params); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "json is not an object: %s", err)
+		}
+	}
+
+	if action, ok := params["action"]; ok && action != pbRequest.Action {
+		return nil, status.Error(codes.InvalidArgument, "json action differs from the requested one")
+	}
+	params["action"] = pbRequest.Action
+
+	if err := checkRaw(claimsFromContext(ctx), pbRequest.Action, params); err != nil {
+		return nil, err
+	}
+
+	request, _ := json.Marshal(params)
+
+	logger.Debug("IPC -< ", string(request))
+
+	jreply, err := server.usClient.Get(ctx, request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, contextStatus(ctx)
+		}
+		logger.Errorf("error from nano ipc: %s", err)
+		return nil, ipcError(err)
+	}
+
+	reply := struct {
+		Error *string `json:"error"`
+	}{}
+	if err := json.Unmarshal(jreply, &reply); err != nil {
+		return nil, errorStatus(codes.Internal, "unexpected reply from node", string(jreply))
+	}
+	if reply.Error != nil {
+		return nil, nodeError(*reply.Error)
+	}
+
+	return &pb.RawActionReply{Json: string(jreply)}, nil
+}
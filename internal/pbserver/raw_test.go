@@ -0,0 +1,122 @@
+package pbserver
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestRawRequest(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	returned := `{"count": "1000", "unchecked": "10"}`
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(returned), nil)
+	var s = Server{usClient: &client, RawAllow: []string{"block_count"}}
+
+	reply, err := s.RawRequest(context.Background(), &pb.RawActionRequest{
+		Action: "block_count",
+		Json:   `{"include_cemented": "true"}`,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, returned, reply.Json)
+
+	client.AssertCalled(t, "Get", mock.Anything,
+		jsonMatch(t, `{"action": "block_count", "include_cemented": "true"}`))
+}
+
+func TestRawRequestNodeError(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"error": "Account not found"}`), nil)
+	var s = Server{usClient: &client, RawAllow: []string{RawAllowAll}}
+
+	_, err := s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "account_key"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRawRequestLists(t *testing.T) {
+	var s = Server{}
+
+	_, err := s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "block_count"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	s = Server{RawAllow: []string{RawAllowAll}}
+	_, err = s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "stop"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "work_peer_add"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Wallet changes are left to the typed methods
+	_, err = s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "send"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	s = Server{RawAllow: []string{"block_count"}}
+	_, err = s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "version"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// An explicit deny list replaces the default one
+	s = Server{RawAllow: []string{RawAllowAll}, RawDeny: []string{"version"}}
+	assert.True(t, s.rawAllowed("stop"))
+	assert.False(t, s.rawAllowed("version"))
+}
+
+func TestRawRequestInvalidJSON(t *testing.T) {
+	var s = Server{RawAllow: []string{RawAllowAll}}
+
+	_, err := s.RawRequest(context.Background(), &pb.RawActionRequest{Action: "block_count", Json: `["a"]`})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The action of the json cannot bypass the lists
+	_, err = s.RawRequest(context.Background(), &pb.RawActionRequest{
+		Action: "block_count",
+		Json:   `{"action": "stop"}`,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRawRequestWalletClaims(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"block": "B1"}`), nil)
+	var s = Server{usClient: &client, RawAllow: []string{RawAllowAll}, RawDeny: []string{}}
+
+	ctx := withClaims(jwt.MapClaims{"scope": ScopeRaw, "wallets": "W1", "sources": "nano_a"})
+
+	_, err := s.RawRequest(ctx, &pb.RawActionRequest{
+		Action: "send",
+		Json:   `{"wallet": "W2", "source": "nano_a", "destination": "nano_b", "amount": "1"}`,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.RawRequest(ctx, &pb.RawActionRequest{
+		Action: "send",
+		Json:   `{"wallet": "W1", "source": "nano_c", "destination": "nano_b", "amount": "1"}`,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.RawRequest(ctx, &pb.RawActionRequest{
+		Action: "receive",
+		Json:   `{"wallet": "W2", "account": "nano_a", "block": "H1"}`,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.RawRequest(ctx, &pb.RawActionRequest{Action: "account_create", Json: `{"wallet": "W2"}`})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	client.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+
+	_, err = s.RawRequest(ctx, &pb.RawActionRequest{
+		Action: "send",
+		Json:   `{"wallet": "W1", "source": "nano_a", "destination": "nano_b", "amount": "1"}`,
+	})
+	require.Nil(t, err)
+	client.AssertNumberOfCalls(t, "Get", 1)
+}
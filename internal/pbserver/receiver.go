@@ -0,0 +1,231 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/alvistar/nanopb/internal/nwsclient"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"math/big"
+	"sort"
+	"time"
+)
+
+const (
+	defaultReceiveInterval = 300
+	receiveBuffer          = 1000
+	// Receiving may wait for the node to generate work
+	receiveTimeout = 2 * time.Minute
+	listTimeout    = 30 * time.Second
+)
+
+// ConfReceive configures the automatic receive of the sends to the accounts of a wallet
+type ConfReceive struct {
+	Wallet string `json:"wallet"`
+	// Minimum amount in raw received automatically. Any amount if empty.
+	Threshold string `json:"threshold"`
+	// Seconds between sweeps of the receivable blocks, which also pick up the
+	// new accounts of the wallet. Default is 300.
+	Interval int `json:"interval"`
+}
+
+// receiver receives the confirmed sends to the wallet accounts as they show
+// up on the confirmation stream. Periodic sweeps of accounts_pending catch
+// the sends missed while disconnected or dropped from the buffer.
+type receiver struct {
+	server    *Server
+	wallet    string
+	threshold *big.Int
+	interval  time.Duration
+
+	accounts []string
+	channel  *chan pb.SubscriptionEntry
+	dropped  uint64
+	// Blocks received since the last sweep
+	received map[string]bool
+}
+
+func newReceiver(server *Server, conf *ConfReceive) (*receiver, error) {
+	if conf.Wallet == "" {
+		return nil, errors.New("auto receive requires a wallet")
+	}
+
+	r := receiver{
+		server:   server,
+		wallet:   conf.Wallet,
+		interval: time.Duration(conf.Interval) * time.Second,
+		received: map[string]bool{},
+	}
+
+	if conf.Interval <= 0 {
+		r.interval = defaultReceiveInterval * time.Second
+	}
+
+	if conf.Threshold != "" {
+		threshold, ok := new(big.Int).SetString(conf.Threshold, 10)
+		if !ok || threshold.Sign() < 0 {
+			return nil, fmt.Errorf("invalid receive threshold %q", conf.Threshold)
+		}
+		r.threshold = threshold
+	}
+
+	return &r, nil
+}
+
+func (r *receiver) run() {
+	logger.Infof("Receiving automatically the sends to wallet %s", r.wallet)
+
+	r.refresh()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		var entries chan pb.SubscriptionEntry
+		if r.channel != nil {
+			entries = *r.channel
+		}
+
+		select {
+		case entry := <-entries:
+			r.handle(entry)
+		case <-ticker.C:
+			r.refresh()
+		}
+	}
+}
+
+// handle receives the send of a confirmation, or sweeps when confirmations
+// may have been missed
+func (r *receiver) handle(entry pb.SubscriptionEntry) {
+	if entry.Topic == "status" {
+		if entry.Status == pb.ConnectionStatus_CONNECTED {
+			r.sweep()
+		}
+		return
+	}
+
+	if entry.Dropped > r.dropped {
+		r.dropped = entry.Dropped
+		r.sweep()
+	}
+
+	r.receive(entry.GetMessage().GetBlock().GetLinkAsAccount(), entry.GetMessage().GetHash())
+}
+
+// refresh reloads the wallet accounts, subscribing again if they changed, and sweeps
+func (r *receiver) refresh() {
+	accounts, err := r.walletAccounts()
+	if err != nil {
+		logger.Errorf("listing accounts of wallet %s: %s", r.wallet, err)
+	} else if !equalStrings(accounts, r.accounts) {
+		r.subscribe(accounts)
+	}
+
+	r.sweep()
+}
+
+func (r *receiver) walletAccounts() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+
+	request, _ := json.Marshal(map[string]string{"action": "account_list", "wallet": r.wallet})
+
+	reply, err := r.server.call(ctx, string(request))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]string, 0)
+	for _, child := range reply.Path("accounts").Children() {
+		if account, ok := child.Data().(string); ok {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Strings(accounts)
+
+	return accounts, nil
+}
+
+func (r *receiver) subscribe(accounts []string) {
+	if r.channel != nil {
+		r.server.wsClient.Unsubscribe(r.channel)
+		r.channel, r.dropped = nil, 0
+	}
+
+	r.accounts = accounts
+
+	// An empty filter would match every account
+	if len(accounts) == 0 {
+		return
+	}
+
+	ch := make(chan pb.SubscriptionEntry, receiveBuffer)
+	r.channel = &ch
+	r.server.wsClient.SubscribeFilter(r.channel, nwsclient.Filter{
+		Accounts:  accounts,
+		Match:     pb.AccountMatch_RECIPIENT,
+		Subtypes:  []string{"send"},
+		MinAmount: r.threshold,
+	}, pb.OverflowPolicy_DROP_OLDEST)
+}
+
+// sweep receives the confirmed receivable blocks of the wallet accounts
+func (r *receiver) sweep() {
+	r.received = map[string]bool{}
+
+	if len(r.accounts) == 0 {
+		return
+	}
+
+	request := pb.AccountsPendingRequest{Accounts: r.accounts, IncludeOnlyConfirmed: true}
+	if r.threshold != nil {
+		request.Threshold = r.threshold.String()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	pending, err := r.server.AccountsPending(ctx, &request)
+	cancel()
+
+	if err != nil {
+		logger.Errorf("listing receivable blocks of wallet %s: %s", r.wallet, err)
+		return
+	}
+
+	for _, account := range r.accounts {
+		for _, block := range pending.Blocks[account].GetBlocks() {
+			r.receive(account, block.Hash)
+		}
+	}
+}
+
+func (r *receiver) receive(account string, block string) {
+	if account == "" || block == "" || r.received[block] {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), receiveTimeout)
+	defer cancel()
+
+	reply, err := r.server.Receive(ctx, &pb.ReceiveRequest{Wallet: r.wallet, Account: account, Block: block})
+	if err != nil {
+		logger.Errorf("receiving block %s to %s: %s", block, account, err)
+		return
+	}
+
+	r.received[block] = true
+	logger.Infof("Received block %s to %s with block %s", block, account, reply.Block)
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
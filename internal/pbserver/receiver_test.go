@@ -0,0 +1,157 @@
+package pbserver
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+// actionRequest matches the requests of the given action
+func actionRequest(name string) interface{} {
+	return mock.MatchedBy(func(request []byte) bool {
+		action := map[string]interface{}{}
+		_ = json.Unmarshal(request, &action)
+		return action["action"] == name
+	})
+}
+
+// sameJSON matches the requests equal to expected, unlike jsonMatch it does
+// not fail on the other calls
+func sameJSON(expected string) interface{} {
+	return mock.MatchedBy(func(request []byte) bool {
+		var a, b interface{}
+		_ = json.Unmarshal([]byte(expected), &a)
+		_ = json.Unmarshal(request, &b)
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+func TestAccountsPending(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{
+		"blocks": {
+			"nano_a": {
+				"H1": {"amount": "6000", "source": "nano_c"},
+				"H2": {"amount": "9000", "source": "nano_d"}
+			},
+			"nano_b": ""
+		}
+	}`), nil)
+
+	var s = Server{usClient: &client}
+
+	reply, err := s.AccountsPending(context.Background(), &pb.AccountsPendingRequest{
+		Accounts:             []string{"nano_a", "nano_b"},
+		Threshold:            "1000",
+		IncludeOnlyConfirmed: true,
+	})
+	require.Nil(t, err)
+
+	blocks := reply.Blocks["nano_a"].Blocks
+	require.Equal(t, 2, len(blocks))
+	assert.Equal(t, pb.PendingBlock{Hash: "H2", Amount: "9000", Source: "nano_d"}, *blocks[0])
+	assert.Equal(t, "H1", blocks[1].Hash)
+	assert.Equal(t, 0, len(reply.Blocks["nano_b"].Blocks))
+
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, `{"action":"accounts_pending",
+		"accounts":["nano_a","nano_b"], "threshold":"1000", "source":"true", "include_only_confirmed":"true"}`))
+}
+
+func TestAccountsPendingDefault(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"blocks": {"nano_a": ""}}`), nil)
+
+	var s = Server{usClient: &client}
+
+	_, err := s.AccountsPending(context.Background(), &pb.AccountsPendingRequest{Accounts: []string{"nano_a"}})
+	require.Nil(t, err)
+
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, `{"action":"accounts_pending",
+		"accounts":["nano_a"], "source":"true"}`))
+}
+
+func TestAccountsPendingInvalidThreshold(t *testing.T) {
+	var s = Server{}
+
+	_, err := s.AccountsPending(context.Background(), &pb.AccountsPendingRequest{Threshold: "-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReceive(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"block": "R1"}`), nil)
+	var s = Server{usClient: &client}
+
+	reply, err := s.Receive(context.Background(), &pb.ReceiveRequest{Wallet: "W1", Account: "nano_a", Block: "H1"})
+	require.Nil(t, err)
+	assert.Equal(t, "R1", reply.Block)
+
+	client.AssertCalled(t, "Get", mock.Anything,
+		jsonMatch(t, `{"action":"receive", "wallet":"W1", "account":"nano_a", "block":"H1"}`))
+}
+
+func TestNewReceiver(t *testing.T) {
+	_, err := newReceiver(&Server{}, &ConfReceive{})
+	assert.NotNil(t, err)
+
+	_, err = newReceiver(&Server{}, &ConfReceive{Wallet: "W1", Threshold: "abc"})
+	assert.NotNil(t, err)
+
+	r, err := newReceiver(&Server{}, &ConfReceive{Wallet: "W1", Threshold: "1000"})
+	require.Nil(t, err)
+	assert.Equal(t, "1000", r.threshold.String())
+}
+
+func TestReceiverSweep(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, actionRequest("account_list")).
+		Return([]byte(`{"accounts": ["nano_b", "nano_a"]}`), nil)
+	client.On("Get", mock.Anything, actionRequest("accounts_pending")).
+		Return([]byte(`{"blocks": {"nano_a": {"H1": {"amount": "6000", "source": "nano_c"}}}}`), nil)
+	client.On("Get", mock.Anything, actionRequest("receive")).Return([]byte(`{"block": "R1"}`), nil)
+
+	var s = Server{usClient: &client}
+	r, err := newReceiver(&s, &ConfReceive{Wallet: "W1", Threshold: "1000"})
+	require.Nil(t, err)
+
+	r.refresh()
+	assert.Equal(t, []string{"nano_a", "nano_b"}, r.accounts)
+	require.NotNil(t, r.channel)
+
+	client.AssertCalled(t, "Get", mock.Anything, sameJSON(`{"action":"accounts_pending",
+		"accounts":["nano_a","nano_b"], "threshold":"1000", "source":"true", "include_only_confirmed":"true"}`))
+	client.AssertCalled(t, "Get", mock.Anything,
+		sameJSON(`{"action":"receive", "wallet":"W1", "account":"nano_a", "block":"H1"}`))
+
+	// The confirmation of a block received by the sweep is skipped
+	r.handle(pb.SubscriptionEntry{
+		Topic: "confirmation",
+		Message: &pb.SubscriptionMessage{
+			Hash:  "H1",
+			Block: &pb.SubscriptionBlock{LinkAsAccount: "nano_a", Subtype: "send"},
+		},
+	})
+	client.AssertNumberOfCalls(t, "Get", 3)
+
+	r.handle(pb.SubscriptionEntry{
+		Topic: "confirmation",
+		Message: &pb.SubscriptionMessage{
+			Hash:  "H2",
+			Block: &pb.SubscriptionBlock{LinkAsAccount: "nano_b", Subtype: "send"},
+		},
+	})
+	client.AssertCalled(t, "Get", mock.Anything,
+		sameJSON(`{"action":"receive", "wallet":"W1", "account":"nano_b", "block":"H2"}`))
+}
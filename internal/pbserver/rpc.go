@@ -3,14 +3,50 @@ package pbserver
 import (
 	"context"
 	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
 )
 
+// AccountBalance uses JSON when the replies are cached, so the balances share
+// the entries and the invalidations of the other account requests
 func (server *Server) AccountBalance(ctx context.Context, pbRequest *pb.AccountBalanceRequest) (*pb.AccountBalanceReply, error) {
+	if server.useFlatbuffers() && server.cache == nil {
+		balance := nanoapi.AccountBalanceResponse{}
+		if err := server.messageHandler(ctx, nanoipc.AccountBalanceMessage(pbRequest.Account),
+			nanoapi.MessageAccountBalanceResponse, &balance); err != nil {
+			return nil, err
+		}
+
+		return &pb.AccountBalanceReply{
+			Balance: string(balance.Balance()),
+			Pending: string(balance.Pending()),
+		}, nil
+	}
+
 	request, _ := getAction(pbRequest, "account_balance", nil)
 
 	reply := pb.AccountBalanceReply{}
 
-	if err := server.handler(request, &reply); err == nil {
+	if err := server.handler(ctx, request, &reply); err == nil {
+		return &reply, nil
+	} else {
+		return nil, err
+	}
+}
+
+func (server *Server) AccountInfo(ctx context.Context, pbRequest *pb.AccountInfoRequest) (*pb.AccountInfoReply, error) {
+	transform := TransformOpt{
+		"representative":    boolToStr(),
+		"weight":            boolToStr(),
+		"pending":           boolToStr(),
+		"include_confirmed": boolToStr(),
+	}
+
+	request, _ := getAction(pbRequest, "account_info", transform)
+
+	reply := pb.AccountInfoReply{}
+
+	if err := server.handler(ctx, request, &reply); err == nil {
 		return &reply, nil
 	} else {
 		return nil, err
@@ -22,7 +58,7 @@ func (server *Server) AccountCreate(ctx context.Context, pbRequest *pb.AccountCr
 
 	reply := pb.AccountCreateReply{}
 
-	if err := server.handler(request, &reply); err == nil {
+	if err := server.handler(ctx, request, &reply); err == nil {
 		return &reply, nil
 	} else {
 		return nil, err
@@ -34,7 +70,7 @@ func (server *Server) ValidateAccountNumber(ctx context.Context, pbRequest *pb.V
 
 	reply := pb.ValidateAccountNumberReply{}
 
-	if err := server.handler(request, &reply); err == nil {
+	if err := server.handler(ctx, request, &reply); err == nil {
 		return &reply, nil
 	} else {
 		return nil, err
@@ -42,11 +78,33 @@ func (server *Server) ValidateAccountNumber(ctx context.Context, pbRequest *pb.V
 }
 
 func (server *Server) Send(ctx context.Context, pbRequest *pb.SendRequest) (*pb.SendReply, error) {
+	if pbRequest.Id != "" && server.sends != nil {
+		return server.idempotentSend(ctx, pbRequest)
+	}
+
+	return server.send(ctx, pbRequest)
+}
+
+// send forwards the request to the node, which also uses the id to avoid
+// sending twice
+func (server *Server) send(ctx context.Context, pbRequest *pb.SendRequest) (*pb.SendReply, error) {
 	request, _ := getAction(pbRequest, "send", nil)
 
 	reply := pb.SendReply{}
 
-	if err := server.handler(request, &reply); err == nil {
+	if err := server.handler(ctx, request, &reply); err == nil {
+		return &reply, nil
+	} else {
+		return nil, err
+	}
+}
+
+func (server *Server) Receive(ctx context.Context, pbRequest *pb.ReceiveRequest) (*pb.ReceiveReply, error) {
+	request, _ := getAction(pbRequest, "receive", nil)
+
+	reply := pb.ReceiveReply{}
+
+	if err := server.handler(ctx, request, &reply); err == nil {
 		return &reply, nil
 	} else {
 		return nil, err
@@ -59,7 +117,7 @@ func (server *Server) AccountsBalances(ctx context.Context, pbRequest *pb.Accoun
 
 	reply := pb.AccountsBalancesReply{}
 
-	if err := server.handler(request, &reply); err == nil {
+	if err := server.handler(ctx, request, &reply); err == nil {
 		return &reply, nil
 	} else {
 		return nil, err
@@ -67,6 +125,7 @@ func (server *Server) AccountsBalances(ctx context.Context, pbRequest *pb.Accoun
 
 }
 
+// BlockInfo always uses JSON, as the flatbuffers API of the node has no block info
 func (server *Server) BlockInfo(ctx context.Context, pbRequest *pb.BlockInfoRequest) (*pb.BlockInfoReply, error) {
 	transform := TransformOpt{
 		"json_block": str("true"),
@@ -77,50 +136,10 @@ func (server *Server) BlockInfo(ctx context.Context, pbRequest *pb.BlockInfoRequ
 
 	reply := pb.BlockInfoReply{}
 
-	if err := server.handler(request, &reply); err == nil {
+	if err := server.handler(ctx, request, &reply); err == nil {
 		return &reply, nil
 	} else {
 		return nil, err
 	}
 
 }
-
-func (server *Server) BlocksInfo(request *pb.BlocksInfoRequest, stream pb.Nano_BlocksInfoServer) error {
-	for _, hash := range request.Hashes {
-		bir := pb.BlockInfoRequest{Hash: hash}
-		bip, err := server.BlockInfo(context.Background(), &bir)
-
-		if err != nil {
-			return err
-		}
-		
-		reply := pb.BlocksInfoReply{
-			BlockHash: hash,
-			Block:     bip,
-		}
-
-		if err := stream.Send(&reply); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-//func (server *Server) BlocksInfo(ctx context.Context, pbRequest *pb.BlocksInfoRequest) (*pb.BlocksInfoReply, error) {
-//	transform := TransformOpt{
-//		"json_block": str("true"),
-//		"include_not_found": boolToStr(),
-//	}
-//
-//	request, _ := getAction(pbRequest, "blocks_info",
-//		transform)
-//
-//	reply := pb.BlocksInfoReply{}
-//
-//	if err := server.handler(request, &reply); err == nil {
-//		return &reply, nil
-//	} else {
-//		return nil, err
-//	}
-//}
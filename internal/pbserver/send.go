@@ -0,0 +1,80 @@
+package pbserver
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/internal/sendlog"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lockSend waits until no other send with the same id is in progress. The
+// returned function releases the id.
+func (server *Server) lockSend(ctx context.Context, id string) (func(), error) {
+	for {
+		server.sendMutex.Lock()
+		if server.sendLocks == nil {
+			server.sendLocks = map[string]chan struct{}{}
+		}
+
+		busy, ok := server.sendLocks[id]
+		if !ok {
+			done := make(chan struct{})
+			server.sendLocks[id] = done
+			server.sendMutex.Unlock()
+
+			return func() {
+				server.sendMutex.Lock()
+				delete(server.sendLocks, id)
+				server.sendMutex.Unlock()
+				close(done)
+			}, nil
+		}
+		server.sendMutex.Unlock()
+
+		select {
+		case <-busy:
+		case <-ctx.Done():
+			return nil, contextStatus(ctx)
+		}
+	}
+}
+
+// idempotentSend returns the recorded reply of a send with the same id, or
+// sends and records the block
+func (server *Server) idempotentSend(ctx context.Context, pbRequest *pb.SendRequest) (*pb.SendReply, error) {
+	unlock, err := server.lockSend(ctx, pbRequest.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	record := sendlog.Record{
+		ID:          pbRequest.Id,
+		Wallet:      pbRequest.Wallet,
+		Source:      pbRequest.Source,
+		Destination: pbRequest.Destination,
+		Amount:      pbRequest.Amount,
+	}
+
+	if previous, ok := server.sends.Get(pbRequest.Id); ok {
+		if !previous.Same(&record) {
+			return nil, status.Errorf(codes.AlreadyExists, "id %s already used by a different send", pbRequest.Id)
+		}
+		logger.Infof("Replaying send %s", pbRequest.Id)
+		return &pb.SendReply{Block: previous.Block}, nil
+	}
+
+	reply, err := server.send(ctx, pbRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// The node still knows the id, a replay after this error reaches it again
+	record.Block = reply.Block
+	if err := server.sends.Put(&record); err != nil {
+		logger.Errorf("recording send %s: %s", pbRequest.Id, err)
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,107 @@
+package pbserver
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/internal/sendlog"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func openSendLog(t *testing.T, dir string) *sendlog.Log {
+	sends, err := sendlog.Open(filepath.Join(dir, "sends.log"))
+	require.Nil(t, err)
+	return sends
+}
+
+func TestSendWithID(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"block": "ABCD"}`), nil)
+	var s = Server{usClient: &client}
+
+	reply, err := s.Send(context.Background(), &pb.SendRequest{Wallet: "W1", Source: "nano_a",
+		Destination: "nano_b", Amount: "1000", Id: "tx1"})
+	require.Nil(t, err)
+	assert.Equal(t, "ABCD", reply.Block)
+
+	expected := `{"action":"send", "wallet":"W1", "source":"nano_a", "destination":"nano_b",
+		"amount":"1000", "id":"tx1"}`
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, expected))
+}
+
+func TestSendReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sendlog")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	client := mocks.IUSClient{}
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"block": "ABCD"}`), nil).Once()
+
+	request := pb.SendRequest{Wallet: "W1", Source: "nano_a", Destination: "nano_b", Amount: "1000", Id: "tx1"}
+
+	var s = Server{usClient: &client, sends: openSendLog(t, dir)}
+	_, err = s.Send(context.Background(), &request)
+	require.Nil(t, err)
+	require.Nil(t, s.sends.Close())
+
+	// A restarted gateway returns the recorded block without calling the node
+	var restarted = Server{usClient: &client, sends: openSendLog(t, dir)}
+	defer restarted.sends.Close()
+
+	reply, err := restarted.Send(context.Background(), &request)
+	require.Nil(t, err)
+	assert.Equal(t, "ABCD", reply.Block)
+	client.AssertNumberOfCalls(t, "Get", 1)
+
+	different := request
+	different.Amount = "2000"
+	_, err = restarted.Send(context.Background(), &different)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestSendNotRecordedOnError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sendlog")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	client := mocks.IUSClient{}
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"error": "Insufficient balance"}`), nil)
+
+	var s = Server{usClient: &client, sends: openSendLog(t, dir)}
+	defer s.sends.Close()
+
+	_, err = s.Send(context.Background(), &pb.SendRequest{Wallet: "W1", Amount: "1000", Id: "tx1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, 0, s.sends.Len())
+}
+
+func TestLockSend(t *testing.T) {
+	var s = Server{}
+
+	unlock, err := s.lockSend(context.Background(), "tx1")
+	require.Nil(t, err)
+
+	// Other ids are not blocked
+	other, err := s.lockSend(context.Background(), "tx2")
+	require.Nil(t, err)
+	other()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.lockSend(ctx, "tx1")
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	unlock()
+	unlock, err = s.lockSend(context.Background(), "tx1")
+	require.Nil(t, err)
+	unlock()
+}
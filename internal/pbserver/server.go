@@ -2,22 +2,24 @@ package pbserver
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"crypto/rsa"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/alvistar/nanopb/internal/journal"
 	"github.com/alvistar/nanopb/internal/nwsclient"
+	"github.com/alvistar/nanopb/internal/sendlog"
 	"github.com/alvistar/nanopb/internal/usclient"
 	pb "github.com/alvistar/nanopb/nanoproto"
-	"github.com/dgrijalva/jwt-go"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	log "github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"io/ioutil"
+	"math/big"
 	"runtime/debug"
+	"sync"
+	"time"
 )
 
 type TransformF = func(interface{}) interface{}
@@ -26,11 +28,6 @@ type TransformOpt map[string]TransformF
 
 var logger *log.Entry
 
-var (
-	errMissingMetadata = status.Errorf(codes.InvalidArgument, "missing metadata")
-	errInvalidToken    = status.Errorf(codes.Unauthenticated, "invalid token")
-)
-
 func str(s string) TransformF {
 	return func(v interface{}) interface{} { return s }
 }
@@ -67,43 +64,165 @@ func getAction(message proto.Message, action string, options TransformOpt) (stri
 
 type Server struct {
 	USConfig      *usclient.ConfNode
+	WSConfig      *nwsclient.ConfWS
 	usClient      usclient.IUSClient
 	wsClient      nwsclient.WSClient
+	// PEM encoded RSA key verifying the JWT of the requests
 	PubKey        []byte
+	// Required aud and iss claims of the JWT. Not checked if empty.
+	Audience      string
+	Issuer        string
+	// Accept JWT without exp claim, which never expire
+	AllowNoExp    bool
+	jwtKey        *rsa.PublicKey
+	// API keys accepted besides the JWT. Disabled if nil.
+	APIKeys       *KeyStore
+	// Scopes required by each method. DefaultPolicy if nil.
+	Policy        Policy
 	LocalAccounts bool
+	// Entries buffered for each Subscribe stream. Default is 1000.
+	SubscriptionBuffer int
+	// Milliseconds a BLOCK subscriber may hold up the confirmations before
+	// its stream is closed. Default is 5000.
+	BlockTimeout int
+	// Directory of the confirmation journal used to resume streams. Disabled if empty.
+	JournalDir string
+	// Confirmations retained in the journal. Default is 100000.
+	JournalSize int
+	// Journal the confirmations of every account instead of the subscribed
+	// ones only, so that streams resuming with other accounts miss nothing.
+	// The node then sends every confirmation.
+	JournalAll bool
+	journal     *journal.Journal
+	// Actions forwarded by RawRequest, RawAllowAll for any. Disabled if empty.
+	RawAllow []string
+	// Actions refused by RawRequest even if allowed. DefaultRawDeny if nil.
+	RawDeny []string
+	// Hashes per blocks_info request of BlocksInfo. Default is 100.
+	BlocksInfoBatch int
+	// Receives automatically the sends to a wallet. Disabled if nil.
+	AutoReceive *ConfReceive
+	// File recording the block of each send with an id, so replays survive
+	// restarts. Replays rely on the node only if empty.
+	SendLog   string
+	sends     *sendlog.Log
+	sendMutex sync.Mutex
+	sendLocks map[string]chan struct{}
+	// Caches the node replies. Disabled if nil.
+	Cache *ConfCache
+	cache *responseCache
 }
 
-func (server *Server) Init(l *log.Logger) {
-	server.usClient = &usclient.USClient{}
-	server.usClient.Init(server.USConfig, l)
-	//server.loadPubKey("key.pem")
-	server.wsClient = nwsclient.WSClient{LocalAccounts: server.LocalAccounts}
-	server.wsClient.Init(l)
+func (server *Server) subscriptionBuffer() int {
+	if server.SubscriptionBuffer <= 0 {
+		return 1000
+	}
+	return server.SubscriptionBuffer
+}
+
+func (server *Server) blocksInfoBatch() int {
+	if server.BlocksInfoBatch <= 0 {
+		return 100
+	}
+	return server.BlocksInfoBatch
+}
+
+// poolSize returns the number of IPC sessions, which bounds the useful
+// concurrency of the requests
+func (server *Server) poolSize() int {
+	if server.USConfig == nil || server.USConfig.PoolSize <= 0 {
+		return 3
+	}
+	return server.USConfig.PoolSize
+}
 
+func (server *Server) Init(l *log.Logger) {
 	if l == nil {
 		l = log.New()
 	}
 
 	logger = l.WithFields(log.Fields{"component": "npb_server"})
+
+	server.usClient = &usclient.USClient{}
+	server.usClient.Init(server.USConfig, l)
+	server.wsClient = nwsclient.WSClient{
+		LocalAccounts: server.LocalAccounts,
+		BlockTimeout:  time.Duration(server.BlockTimeout) * time.Millisecond,
+	}
+
+	if server.JournalDir != "" {
+		size := server.JournalSize
+		if size <= 0 {
+			size = 100000
+		}
+
+		j, err := journal.Open(server.JournalDir, size)
+		if err != nil {
+			logger.Fatal("opening journal: ", err)
+		}
+		server.journal = j
+		server.wsClient.Journal = j
+		server.wsClient.JournalAll = server.JournalAll
+	}
+
+	if server.SendLog != "" {
+		sends, err := sendlog.Open(server.SendLog)
+		if err != nil {
+			logger.Fatal("opening send log: ", err)
+		}
+		server.sends = sends
+	}
+
+	server.wsClient.Init(server.WSConfig, l)
+
+	if server.Cache != nil {
+		server.cache = newResponseCache(server.usClient, server.Cache)
+
+		// The node sends the confirmations of the local accounts only, which
+		// cannot invalidate the balances of the others
+		if server.LocalAccounts {
+			logger.Warn("Balances are not cached with local accounts")
+		} else {
+			server.cache.follow(&server.wsClient)
+		}
+	}
+
+	if server.AutoReceive != nil {
+		r, err := newReceiver(server, server.AutoReceive)
+		if err != nil {
+			logger.Fatal("starting auto receive: ", err)
+		}
+		go r.run()
+	}
 }
 
-func (server *Server) loadPubKey(filename string) {
-	keyData, e := ioutil.ReadFile(filename)
-	if e != nil {
-		panic(e.Error())
+// contextStatus converts the error of a done context into a gRPC status
+func contextStatus(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
 	}
+	return status.Error(codes.Canceled, ctx.Err().Error())
+}
 
-	server.PubKey = keyData
+// get sends a JSON request to the node, through the cache if enabled
+func (server *Server) get(ctx context.Context, request string) ([]byte, error) {
+	if server.cache != nil {
+		return server.cache.get(ctx, request)
+	}
+	return server.usClient.Get(ctx, []byte(request))
 }
 
-func (server *Server) handler(request string, reply proto.Message) ( error) {
+func (server *Server) handler(ctx context.Context, request string, reply proto.Message) ( error) {
 	logger.Debug("IPC -< ", request)
 
-	jreply, err := server.usClient.Get([]byte(request))
+	jreply, err := server.get(ctx, request)
 
 	if err != nil {
-		logger.Error("error from nano ipc: %s", err)
-		return err
+		if ctx.Err() != nil {
+			return contextStatus(ctx)
+		}
+		logger.Errorf("error from nano ipc: %s", err)
+		return ipcError(err)
 	}
 
 	if err := jsonpb.UnmarshalString(string(jreply), reply); err != nil {
@@ -112,87 +231,195 @@ func (server *Server) handler(request string, reply proto.Message) ( error) {
 		if jsonParsed, err := gabs.ParseJSON(jreply); err == nil {
 			apiErr, ok := jsonParsed.Path("error").Data().(string)
 			if ok {
-				return errors.New(apiErr)
+				return nodeError(apiErr)
 			}
 		}
 
 		logger.Error("error unmarshalling json: ", err)
 		logger.Error(string(jreply))
 		debug.PrintStack()
-		return err
+		return errorStatus(codes.Internal, "unexpected reply from node", string(jreply))
 	}
 
 	return nil
 }
 
-func (server *Server) unsubscribe(channel *chan pb.SubscriptionEntry) {
-	logger.Debug("unsubscribing channel")
-	server.wsClient.Unsubscribe(channel)
+// call sends a JSON request and returns the parsed reply, for replies which
+// cannot be unmarshalled directly into a message
+func (server *Server) call(ctx context.Context, request string) (*gabs.Container, error) {
+	logger.Debug("IPC -< ", request)
+
+	jreply, err := server.get(ctx, request)
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, contextStatus(ctx)
+		}
+		logger.Errorf("error from nano ipc: %s", err)
+		return nil, ipcError(err)
+	}
+
+	jsonParsed, err := gabs.ParseJSON(jreply)
+	if err != nil {
+		logger.Error("error parsing json: ", err)
+		return nil, errorStatus(codes.Internal, "unexpected reply from node", string(jreply))
+	}
+
+	if apiErr, ok := jsonParsed.Path("error").Data().(string); ok {
+		return nil, nodeError(apiErr)
+	}
+
+	return jsonParsed, nil
 }
 
-func (server *Server) Subscribe(request *pb.SubscribeRequest, stream pb.Nano_SubscribeServer) error {
-	ch := make(chan pb.SubscriptionEntry)
-	server.wsClient.Subscribe(&ch, request.Accounts)
-	for entry := range ch {
-		if err := stream.Send(&entry); err != nil {
-			server.unsubscribe(&ch)
-			return err
+// useFlatbuffers reports whether typed requests should use the flatbuffers encoding
+func (server *Server) useFlatbuffers() bool {
+	return server.USConfig != nil && server.USConfig.Encoding == usclient.EncodingFlatbuffers
+}
+
+// messageHandler sends a typed request and unpacks the reply into table
+func (server *Server) messageHandler(ctx context.Context, message nanoipc.Message, expected nanoapi.Message, table nanoipc.Table) error {
+	logger.Debug("IPC -< ", nanoapi.EnumNamesMessage[message.Type])
+
+	envelope, err := server.usClient.GetMessage(ctx, message)
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return contextStatus(ctx)
 		}
+		logger.Errorf("error from nano ipc: %s", err)
+		return ipcError(err)
 	}
 
-	server.unsubscribe(&ch)
+	if err := nanoipc.Unpack(envelope, expected, table); err != nil {
+		logger.Error("error unpacking reply: ", err)
+		return ipcError(err)
+	}
 
 	return nil
 }
 
-// valid validates the authorization.
-func valid(authorization []string, key []byte) bool {
-	if len(authorization) < 1 {
-		return false
+// Status returns the state of the nodes and of the websocket connection
+func (server *Server) Status(ctx context.Context, request *pb.StatusRequest) (*pb.StatusReply, error) {
+	reply := pb.StatusReply{WebsocketConnected: server.wsClient.Connected()}
+
+	for _, node := range server.usClient.Status() {
+		reply.Nodes = append(reply.Nodes, &pb.NodeStatus{
+			Connection: node.Connection,
+			Role:       node.Role,
+			Healthy:    node.Healthy,
+			Synced:     node.Synced,
+			BlockCount: node.BlockCount,
+			Version:    node.Version,
+			Breaker:    node.Breaker,
+			Failures:   uint32(node.Failures),
+		})
 	}
 
-	jkey, _ := jwt.ParseRSAPublicKeyFromPEM(key)
+	return &reply, nil
+}
+
+func (server *Server) unsubscribe(channel *chan pb.SubscriptionEntry) {
+	logger.Debug("unsubscribing channel")
+	server.wsClient.Unsubscribe(channel)
+}
+
+// subscriptionFilter validates the request and converts it into a nwsclient.Filter
+func subscriptionFilter(request *pb.SubscribeRequest) (nwsclient.Filter, error) {
+	filter := nwsclient.Filter{
+		Accounts: request.Accounts,
+		Match:    request.Match,
+		Subtypes: request.Subtypes,
+	}
 
-	token, err := jwt.Parse(authorization[0], func(token *jwt.Token) (interface{}, error) {
-		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	for _, subtype := range request.Subtypes {
+		if !stringInSlice(subtype, nwsclient.Subtypes) {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid subtype %q", subtype)
 		}
+	}
 
-		// hmacSampleSecret is a []byte containing your secret, e.g. []byte("my_secret_key")
-		return jkey, nil
-	})
+	if request.MinAmount != "" {
+		amount, ok := new(big.Int).SetString(request.MinAmount, 10)
+		if !ok || amount.Sign() < 0 {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid min_amount %q", request.MinAmount)
+		}
+		filter.MinAmount = amount
+	}
 
+	return filter, nil
+}
+
+func stringInSlice(a string, list []string) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+func (server *Server) Subscribe(request *pb.SubscribeRequest, stream pb.Nano_SubscribeServer) error {
+	filter, err := subscriptionFilter(request)
 	if err != nil {
-		log.Printf("error validating token:%s", err)
-		return false
+		return err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		fmt.Println(claims["some"], claims["nbf"])
-	} else {
-		log.Printf("error validating token:%s", err)
+	if request.ResumeFrom > 0 && server.journal == nil {
+		return status.Error(codes.FailedPrecondition, "resume_from requires the gateway journal")
 	}
 
-	return true
-}
+	// A sequence the journal has not reached yet comes from another journal
+	if request.ResumeFrom > 0 && request.ResumeFrom > server.journal.Last() {
+		return status.Errorf(codes.OutOfRange, "cannot resume from %d: the journal ends at %d",
+			request.ResumeFrom, server.journal.Last())
+	}
+
+	ch := make(chan pb.SubscriptionEntry, server.subscriptionBuffer())
+	subscription, err := server.wsClient.SubscribeFilter(&ch, filter, request.Overflow)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer server.unsubscribe(&ch)
+
+	if !server.wsClient.Connected() {
+		entry := pb.SubscriptionEntry{Topic: "status", Status: pb.ConnectionStatus_RECONNECTING}
+		if err := stream.Send(&entry); err != nil {
+			return err
+		}
+	}
 
-// EnsureValidToken ensures a valid token exists within a request's metadata. If
-// the token is missing or invalid, the interceptor blocks execution of the
-// handler and returns an error. Otherwise, the interceptor invokes the unary
-// handler.
-func EnsureValidToken(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	// Live entries are already being buffered, replay what was missed before them
+	var lastSent uint64
+	if request.ResumeFrom > 0 {
+		var err error
+		lastSent, err = server.journal.Replay(request.ResumeFrom, func(entry *pb.SubscriptionEntry) error {
+			if !filter.Matches(entry) {
+				return nil
+			}
+			return stream.Send(entry)
+		})
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errMissingMetadata
+		if err == journal.ErrTruncated {
+			return status.Errorf(codes.OutOfRange, "cannot resume from %d: %s", request.ResumeFrom, err)
+		} else if err != nil {
+			return err
+		}
 	}
 
-	// The keys within metadata.MD are normalized to lowercase.
-	// See: https://godoc.org/google.golang.org/grpc/metadata#New
-	if !valid(md["auth-token-bin"], info.Server.(*Server).PubKey) {
-		return nil, errInvalidToken
+	for {
+		select {
+		case entry := <-ch:
+			if entry.Sequence != 0 && entry.Sequence <= lastSent {
+				continue
+			}
+			if err := stream.Send(&entry); err != nil {
+				return err
+			}
+		case <-subscription.Overflowed():
+			return status.Errorf(codes.ResourceExhausted,
+				"subscription buffer of %d entries is full", cap(ch))
+		case <-stream.Context().Done():
+			return contextStatus(stream.Context())
+		}
 	}
-	// Continue execution of handler after ensuring a valid token.
-	return handler(ctx, req)
 }
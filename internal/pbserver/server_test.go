@@ -2,13 +2,21 @@ package pbserver
 
 import (
 	"context"
+	"github.com/alvistar/nanopb/internal/journal"
+	"github.com/alvistar/nanopb/internal/usclient"
 	"github.com/alvistar/nanopb/internal/usclient/mocks"
 	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
 	"github.com/golang/protobuf/jsonpb"
+	flatbuffers "github.com/google/flatbuffers/go"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
 	"os"
 	"testing"
 )
@@ -75,7 +83,7 @@ func TestBlockInfo(t *testing.T) {
 
 	client := mocks.IUSClient{}
 
-	client.On("Get", mock.Anything).Return(returned, nil)
+	client.On("Get", mock.Anything, mock.Anything).Return(returned, nil)
 	var s = Server{usClient: &client}
 	var msg = pb.BlockInfoRequest{
 		Hash:"1234",
@@ -84,7 +92,7 @@ func TestBlockInfo(t *testing.T) {
 	var reply *pb.BlockInfoReply
 	reply, err = s.BlockInfo(context.Background(), &msg)
 	expected := `{"action":"block_info", "json_block":"true", "hash": "1234"}`
-	client.AssertCalled(t, "Get", jsonMatch(t, expected))
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, expected))
 	assert.Nil(t, err)
 	msh := jsonpb.Marshaler{OrigName: true}
 	replys, err := msh.MarshalToString(reply)
@@ -97,7 +105,7 @@ func TestBlockInfoError(t *testing.T) {
 
 	client := mocks.IUSClient{}
 
-	client.On("Get", mock.Anything).Return([]byte(`{"error":"myerror"}`), nil)
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"error":"myerror"}`), nil)
 	var s = Server{usClient: &client}
 	var msg = pb.BlockInfoRequest{
 		Hash:"1234",
@@ -106,13 +114,119 @@ func TestBlockInfoError(t *testing.T) {
 	var reply *pb.BlockInfoReply
 	reply, err = s.BlockInfo(context.Background(), &msg)
 	expected := `{"action":"block_info", "json_block":"true", "hash": "1234"}`
-	client.AssertCalled(t, "Get", jsonMatch(t, expected))
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, expected))
 	assert.Nil(t, reply)
 	assert.Error(t, err)
-	assert.Equal(t, "myerror", err.Error())
+	assert.Equal(t, codes.Unknown, status.Code(err))
+	assert.Equal(t, "myerror", status.Convert(err).Message())
 
 }
 
+func TestBlockInfoCanceled(t *testing.T) {
+
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return(nil, &nanoipc.Error{Code: 1, Message: "context canceled", Category: "Context"})
+	var s = Server{usClient: &client}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reply, err := s.BlockInfo(ctx, &pb.BlockInfoRequest{Hash: "1234"})
+	assert.Nil(t, reply)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestAccountBalanceFlatbuffers(t *testing.T) {
+	builder := flatbuffers.NewBuilder(0)
+	balance := builder.CreateString("1000")
+	pending := builder.CreateString("20")
+	nanoapi.AccountBalanceResponseStart(builder)
+	nanoapi.AccountBalanceResponseAddBalance(builder, balance)
+	nanoapi.AccountBalanceResponseAddPending(builder, pending)
+	msg := nanoapi.AccountBalanceResponseEnd(builder)
+	nanoapi.EnvelopeStart(builder)
+	nanoapi.EnvelopeAddMessageType(builder, nanoapi.MessageAccountBalanceResponse)
+	nanoapi.EnvelopeAddMessage(builder, msg)
+	builder.Finish(nanoapi.EnvelopeEnd(builder))
+
+	client := mocks.IUSClient{}
+
+	client.On("GetMessage", mock.Anything, mock.Anything).
+		Return(nanoapi.GetRootAsEnvelope(builder.FinishedBytes(), 0), nil)
+	var s = Server{
+		usClient: &client,
+		USConfig: &usclient.ConfNode{Encoding: usclient.EncodingFlatbuffers},
+	}
+
+	reply, err := s.AccountBalance(context.Background(), &pb.AccountBalanceRequest{Account: "nano_a"})
+	require.Nil(t, err)
+	assert.Equal(t, "1000", reply.Balance)
+	assert.Equal(t, "20", reply.Pending)
+	client.AssertCalled(t, "GetMessage", mock.Anything, mock.MatchedBy(func(message nanoipc.Message) bool {
+		return message.Type == nanoapi.MessageAccountBalance
+	}))
+	client.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestBlockInfoJSONFallback(t *testing.T) {
+
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{
+		"block_account": "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est",
+		"amount": "30000000000000000000000000000000000",
+		"height": "58",
+		"confirmed": "true"
+	}`), nil)
+	var s = Server{
+		usClient: &client,
+		USConfig: &usclient.ConfNode{Encoding: usclient.EncodingFlatbuffers},
+	}
+
+	// The flatbuffers API of the node has no block info
+	reply, err := s.BlockInfo(context.Background(), &pb.BlockInfoRequest{Hash: "1234"})
+	require.Nil(t, err)
+	assert.Equal(t, "30000000000000000000000000000000000", reply.Amount)
+	assert.Equal(t, "58", reply.Height)
+	client.AssertCalled(t, "Get", mock.Anything,
+		jsonMatch(t, `{"action": "block_info", "hash": "1234", "json_block": "true"}`))
+	client.AssertNotCalled(t, "GetMessage", mock.Anything, mock.Anything)
+}
+
+func TestAccountInfo(t *testing.T) {
+
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{
+		"frontier": "FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5",
+		"open_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+		"representative_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+		"balance": "235580100176034320859259343606608761791",
+		"modified_timestamp": "1501793775",
+		"block_count": "33",
+		"account_version": "1",
+		"confirmation_height": "28",
+		"confirmation_height_frontier": "34C70FCA0952E29ADC7BEE6F20381466AE42BD1CFBA4B7DFFE8BD69DF95449EB",
+		"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		"weight": "1105577030935649664609129644855132177"
+	}`), nil)
+	var s = Server{usClient: &client}
+
+	reply, err := s.AccountInfo(context.Background(), &pb.AccountInfoRequest{
+		Account:        "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3",
+		Representative: true,
+		Weight:         true,
+	})
+	expected := `{"action":"account_info", "account":"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3",
+		"representative":"true", "weight":"true", "pending":"false", "include_confirmed":"false"}`
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, expected))
+	require.Nil(t, err)
+	assert.Equal(t, "33", reply.BlockCount)
+	assert.Equal(t, "28", reply.ConfirmationHeight)
+	assert.Equal(t, "1105577030935649664609129644855132177", reply.Weight)
+}
+
 func TestGetAction(t *testing.T) {
 	request := pb.AccountsBalancesRequest{Accounts: []string {"123"}}
 	msg, _ := getAction(&request, "test", nil)
@@ -132,26 +246,88 @@ func TestGetActionWithOptions(t *testing.T) {
 	assert.JSONEq(t, `{"action":"test", "accounts": ["123"], "options":"opt1"}`, msg)
 }
 
-//func TestGetActionWithMultipleOptions(t *testing.T) {
-//	request := pb.BlocksInfoRequest{Hashes:[]string {"123", "456"}, IncludeNotFound: true}
-//
-//	transform := TransformOpt{
-//		"options": str("opt1"),
-//		"include_not_found": boolToStr(),
-//	}
-//
-//	msg, _ := getAction(&request, "test", transform)
-//
-//	assert.JSONEq(t, `{"action":"test", "hashes": ["123","456"], "options":"opt1",
-//			"include_not_found":"true"}`, msg)
-//}
+func TestGetActionWithMultipleOptions(t *testing.T) {
+	request := pb.BlocksInfoRequest{Hashes:[]string {"123", "456"}, IncludeNotFound: true}
+
+	transform := TransformOpt{
+		"options": str("opt1"),
+		"include_not_found": boolToStr(),
+	}
+
+	msg, _ := getAction(&request, "test", transform)
+
+	assert.JSONEq(t, `{"action":"test", "hashes": ["123","456"], "options":"opt1",
+			"include_not_found":"true"}`, msg)
+}
+
+
+func TestSubscriptionFilter(t *testing.T) {
+	filter, err := subscriptionFilter(&pb.SubscribeRequest{
+		Accounts:  []string{"nano_1"},
+		Match:     pb.AccountMatch_EITHER,
+		Subtypes:  []string{"send"},
+		MinAmount: "1000",
+	})
+	require.Nil(t, err)
+	assert.Equal(t, pb.AccountMatch_EITHER, filter.Match)
+	assert.Equal(t, int64(1000), filter.MinAmount.Int64())
+
+	_, err = subscriptionFilter(&pb.SubscribeRequest{Subtypes: []string{"open"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = subscriptionFilter(&pb.SubscribeRequest{MinAmount: "1.5"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStatus(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Status").Return([]usclient.NodeStatus{
+		{Connection: "local:///tmp/primary", Role: usclient.RolePrimary, Healthy: true, Synced: true,
+			BlockCount: 5000, Version: "Nano V21.3", Breaker: usclient.BreakerClosed},
+		{Connection: "local:///tmp/secondary", Role: usclient.RoleSecondary,
+			Breaker: usclient.BreakerOpen, Failures: 5},
+	})
+	var s = Server{usClient: &client}
 
-func TestValid(t *testing.T) {
-	assert.True(t, valid([]string{token}, []byte(pubkey)))
+	reply, err := s.Status(context.Background(), &pb.StatusRequest{})
+	require.Nil(t, err)
+	assert.False(t, reply.WebsocketConnected)
+	require.Len(t, reply.Nodes, 2)
+	assert.Equal(t, uint64(5000), reply.Nodes[0].BlockCount)
+	assert.Equal(t, "Nano V21.3", reply.Nodes[0].Version)
+	assert.Equal(t, usclient.BreakerOpen, reply.Nodes[1].Breaker)
+	assert.Equal(t, uint32(5), reply.Nodes[1].Failures)
+	assert.False(t, reply.Nodes[1].Healthy)
 }
 
-func TestValidWrongKey(t *testing.T) {
-	assert.False(t, valid([]string{token}, []byte(pubkeywrong)))
+type subscribeStream struct {
+	fakeServerStream
+	entries []*pb.SubscriptionEntry
+}
 
+func (s *subscribeStream) Send(entry *pb.SubscriptionEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
 }
 
+func TestSubscribeResumeAhead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	j, err := journal.Open(dir, 100)
+	require.Nil(t, err)
+	defer j.Close()
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, j.Append(&pb.SubscriptionEntry{Topic: "confirmation"}))
+	}
+
+	s := Server{journal: j}
+	stream := &subscribeStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+
+	err = s.Subscribe(&pb.SubscribeRequest{ResumeFrom: 4}, stream)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	assert.Empty(t, stream.entries)
+}
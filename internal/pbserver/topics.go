@@ -0,0 +1,83 @@
+package pbserver
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/internal/nwsclient"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamTopic sends the entries of a websocket topic until the stream ends
+func (server *Server) streamTopic(ctx context.Context, topic string, accounts []string,
+	policy pb.OverflowPolicy, send func(entry proto.Message) error) error {
+	subscription, err := server.wsClient.SubscribeTopic(topic, accounts, server.subscriptionBuffer(), policy)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer server.wsClient.UnsubscribeTopic(subscription)
+
+	for {
+		select {
+		case entry := <-subscription.Entries():
+			if err := send(entry); err != nil {
+				return err
+			}
+		case <-subscription.Overflowed():
+			return status.Errorf(codes.ResourceExhausted,
+				"subscription buffer of %d entries is full", server.subscriptionBuffer())
+		case <-ctx.Done():
+			return contextStatus(ctx)
+		}
+	}
+}
+
+func (server *Server) SubscribeVotes(request *pb.SubscribeVotesRequest, stream pb.Nano_SubscribeVotesServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicVote, request.Representatives, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.VoteEntry))
+		})
+}
+
+func (server *Server) SubscribeStoppedElections(request *pb.TopicRequest, stream pb.Nano_SubscribeStoppedElectionsServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicStoppedElection, nil, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.StoppedElectionEntry))
+		})
+}
+
+func (server *Server) SubscribeActiveDifficulty(request *pb.TopicRequest, stream pb.Nano_SubscribeActiveDifficultyServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicActiveDifficulty, nil, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.ActiveDifficultyEntry))
+		})
+}
+
+func (server *Server) SubscribeWork(request *pb.TopicRequest, stream pb.Nano_SubscribeWorkServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicWork, nil, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.WorkEntry))
+		})
+}
+
+func (server *Server) SubscribeTelemetry(request *pb.TopicRequest, stream pb.Nano_SubscribeTelemetryServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicTelemetry, nil, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.TelemetryEntry))
+		})
+}
+
+func (server *Server) SubscribeNewUnconfirmedBlocks(request *pb.TopicRequest, stream pb.Nano_SubscribeNewUnconfirmedBlocksServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicNewUnconfirmedBlock, nil, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.NewUnconfirmedBlockEntry))
+		})
+}
+
+func (server *Server) SubscribeBootstrap(request *pb.TopicRequest, stream pb.Nano_SubscribeBootstrapServer) error {
+	return server.streamTopic(stream.Context(), nwsclient.TopicBootstrap, nil, request.Overflow,
+		func(entry proto.Message) error {
+			return stream.Send(entry.(*pb.BootstrapEntry))
+		})
+}
@@ -0,0 +1,341 @@
+package pbserver
+
+import (
+	"context"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	pbv2 "github.com/alvistar/nanopb/nanoproto/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"strconv"
+)
+
+// Size in bytes of a raw amount
+const amountSize = 16
+
+// ServerV2 serves the typed v2 API on top of the handlers of Server
+type ServerV2 struct {
+	server *Server
+}
+
+// V2 returns the v2 API of server
+func (server *Server) V2() *ServerV2 {
+	return &ServerV2{server: server}
+}
+
+// amountV2 converts a raw amount in base 10 into a v2 Amount, nil if empty
+func amountV2(decimal string) (*pbv2.Amount, error) {
+	if decimal == "" {
+		return nil, nil
+	}
+
+	value, ok := new(big.Int).SetString(decimal, 10)
+	if !ok || value.Sign() < 0 || value.BitLen() > amountSize*8 {
+		return nil, status.Errorf(codes.Internal, "invalid amount %q from node", decimal)
+	}
+
+	raw := make([]byte, amountSize)
+	b := value.Bytes()
+	copy(raw[amountSize-len(b):], b)
+
+	return &pbv2.Amount{Raw: raw, Decimal: value.String()}, nil
+}
+
+// amountV1 converts a requested v2 Amount into base 10, empty if not set
+func amountV1(amount *pbv2.Amount) (string, error) {
+	if amount == nil {
+		return "", nil
+	}
+
+	var fromRaw *big.Int
+	if len(amount.Raw) > 0 {
+		if len(amount.Raw) != amountSize {
+			return "", status.Errorf(codes.InvalidArgument, "raw amount must be %d bytes", amountSize)
+		}
+		fromRaw = new(big.Int).SetBytes(amount.Raw)
+	}
+
+	if amount.Decimal == "" {
+		if fromRaw == nil {
+			return "", nil
+		}
+		return fromRaw.String(), nil
+	}
+
+	value, ok := new(big.Int).SetString(amount.Decimal, 10)
+	if !ok || value.Sign() < 0 || value.BitLen() > amountSize*8 {
+		return "", status.Errorf(codes.InvalidArgument, "invalid amount %q", amount.Decimal)
+	}
+
+	if fromRaw != nil && fromRaw.Cmp(value) != 0 {
+		return "", status.Error(codes.InvalidArgument, "raw and decimal amounts differ")
+	}
+
+	return value.String(), nil
+}
+
+// converter parses the string fields of v1 replies, keeping the first error
+type converter struct {
+	err error
+}
+
+func (c *converter) amount(decimal string) *pbv2.Amount {
+	if c.err != nil {
+		return nil
+	}
+
+	amount, err := amountV2(decimal)
+	c.err = err
+	return amount
+}
+
+func (c *converter) uint64(s string) uint64 {
+	if c.err != nil || s == "" {
+		return 0
+	}
+
+	value, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		c.err = status.Errorf(codes.Internal, "invalid number %q from node", s)
+	}
+	return value
+}
+
+func (c *converter) bool(s string) bool {
+	if c.err != nil || s == "" {
+		return false
+	}
+
+	value, err := strconv.ParseBool(s)
+	if err != nil {
+		c.err = status.Errorf(codes.Internal, "invalid boolean %q from node", s)
+	}
+	return value
+}
+
+func (c *converter) blockContents(contents *pb.BlockContents) *pbv2.BlockContents {
+	if contents == nil {
+		return nil
+	}
+
+	return &pbv2.BlockContents{
+		Type:           contents.Type,
+		Account:        contents.Account,
+		Previous:       contents.Previous,
+		Representative: contents.Representative,
+		Balance:        c.amount(contents.Balance),
+		Link:           contents.Link,
+		LinkAsAccount:  contents.LinkAsAccount,
+		Signature:      contents.Signature,
+		Work:           contents.Work,
+		Subtype:        contents.Subtype,
+	}
+}
+
+func (c *converter) blockInfo(reply *pb.BlockInfoReply) *pbv2.BlockInfoReply {
+	if reply == nil {
+		return nil
+	}
+
+	return &pbv2.BlockInfoReply{
+		BlockAccount:   reply.BlockAccount,
+		Amount:         c.amount(reply.Amount),
+		Balance:        c.amount(reply.Balance),
+		Height:         c.uint64(reply.Height),
+		LocalTimestamp: c.uint64(reply.LocalTimestamp),
+		Confirmed:      c.bool(reply.Confirmed),
+		Contents:       c.blockContents(reply.Contents),
+		Subtype:        reply.Subtype,
+	}
+}
+
+func (c *converter) subscriptionBlock(block *pb.SubscriptionBlock) *pbv2.SubscriptionBlock {
+	if block == nil {
+		return nil
+	}
+
+	return &pbv2.SubscriptionBlock{
+		Type:           block.Type,
+		Source:         block.Source,
+		Representative: block.Representative,
+		Account:        block.Account,
+		Work:           block.Work,
+		Signature:      block.Signature,
+		Previous:       block.Previous,
+		LinkAsAccount:  block.LinkAsAccount,
+		Subtype:        block.Subtype,
+		Link:           block.Link,
+		Balance:        c.amount(block.Balance),
+		Destination:    block.Destination,
+	}
+}
+
+func (c *converter) subscriptionEntry(entry *pb.SubscriptionEntry) *pbv2.SubscriptionEntry {
+	converted := pbv2.SubscriptionEntry{
+		Topic:    entry.Topic,
+		Time:     c.uint64(entry.Time),
+		Status:   pbv2.ConnectionStatus(entry.Status),
+		Dropped:  entry.Dropped,
+		Sequence: entry.Sequence,
+	}
+
+	if message := entry.Message; message != nil {
+		converted.Message = &pbv2.SubscriptionMessage{
+			Account:          message.Account,
+			Amount:           c.amount(message.Amount),
+			Hash:             message.Hash,
+			ConfirmationType: message.ConfirmationType,
+			Block:            c.subscriptionBlock(message.Block),
+		}
+
+		if info := message.ElectionInfo; info != nil {
+			converted.Message.ElectionInfo = &pbv2.ElectionInfo{
+				Duration:     c.uint64(info.Duration),
+				Time:         c.uint64(info.Time),
+				Tally:        c.amount(info.Tally),
+				RequestCount: c.uint64(info.RequestCount),
+			}
+		}
+	}
+
+	return &converted
+}
+
+func (v2 *ServerV2) AccountBalance(ctx context.Context, request *pbv2.AccountBalanceRequest) (*pbv2.AccountBalanceReply, error) {
+	reply, err := v2.server.AccountBalance(ctx, &pb.AccountBalanceRequest{Account: request.Account})
+	if err != nil {
+		return nil, err
+	}
+
+	c := converter{}
+	converted := pbv2.AccountBalanceReply{
+		Balance: c.amount(reply.Balance),
+		Pending: c.amount(reply.Pending),
+	}
+	return &converted, c.err
+}
+
+func (v2 *ServerV2) AccountsBalances(ctx context.Context, request *pbv2.AccountsBalancesRequest) (*pbv2.AccountsBalancesReply, error) {
+	reply, err := v2.server.AccountsBalances(ctx, &pb.AccountsBalancesRequest{Accounts: request.Accounts})
+	if err != nil {
+		return nil, err
+	}
+
+	c := converter{}
+	converted := pbv2.AccountsBalancesReply{Balances: make(map[string]*pbv2.Balance)}
+	for account, balance := range reply.Balances {
+		converted.Balances[account] = &pbv2.Balance{
+			Balance: c.amount(balance.Balance),
+			Pending: c.amount(balance.Pending),
+		}
+	}
+	return &converted, c.err
+}
+
+func (v2 *ServerV2) AccountCreate(ctx context.Context, request *pbv2.AccountCreateRequest) (*pbv2.AccountCreateReply, error) {
+	reply, err := v2.server.AccountCreate(ctx, &pb.AccountCreateRequest{Wallet: request.Wallet})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.AccountCreateReply{Account: reply.Account}, nil
+}
+
+func (v2 *ServerV2) ValidateAccountNumber(ctx context.Context, request *pbv2.ValidateAccountNumberRequest) (*pbv2.ValidateAccountNumberReply, error) {
+	reply, err := v2.server.ValidateAccountNumber(ctx, &pb.ValidateAccountNumberRequest{Account: request.Account})
+	if err != nil {
+		return nil, err
+	}
+
+	c := converter{}
+	converted := pbv2.ValidateAccountNumberReply{Valid: c.bool(reply.Valid)}
+	return &converted, c.err
+}
+
+func (v2 *ServerV2) Send(ctx context.Context, request *pbv2.SendRequest) (*pbv2.SendReply, error) {
+	amount, err := amountV1(request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := v2.server.Send(ctx, &pb.SendRequest{
+		Wallet:      request.Wallet,
+		Source:      request.Source,
+		Destination: request.Destination,
+		Amount:      amount,
+		Id:          request.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.SendReply{Block: reply.Block}, nil
+}
+
+func (v2 *ServerV2) BlockInfo(ctx context.Context, request *pbv2.BlockInfoRequest) (*pbv2.BlockInfoReply, error) {
+	reply, err := v2.server.BlockInfo(ctx, &pb.BlockInfoRequest{Hash: request.Hash})
+	if err != nil {
+		return nil, err
+	}
+
+	c := converter{}
+	converted := c.blockInfo(reply)
+	return converted, c.err
+}
+
+// blocksInfoStreamV2 converts the replies of the v1 BlocksInfo
+type blocksInfoStreamV2 struct {
+	pbv2.Nano_BlocksInfoServer
+}
+
+func (s blocksInfoStreamV2) Send(reply *pb.BlocksInfoReply) error {
+	c := converter{}
+	converted := pbv2.BlocksInfoReply{
+		BlockHash: reply.BlockHash,
+		Block:     c.blockInfo(reply.Block),
+		NotFound:  reply.NotFound,
+	}
+
+	if c.err != nil {
+		return c.err
+	}
+	return s.Nano_BlocksInfoServer.Send(&converted)
+}
+
+func (v2 *ServerV2) BlocksInfo(request *pbv2.BlocksInfoRequest, stream pbv2.Nano_BlocksInfoServer) error {
+	return v2.server.BlocksInfo(&pb.BlocksInfoRequest{
+		Hashes:          request.Hashes,
+		IncludeNotFound: request.IncludeNotFound,
+	}, blocksInfoStreamV2{stream})
+}
+
+// subscribeStreamV2 converts the entries of the v1 Subscribe
+type subscribeStreamV2 struct {
+	pbv2.Nano_SubscribeServer
+}
+
+func (s subscribeStreamV2) Send(entry *pb.SubscriptionEntry) error {
+	c := converter{}
+	converted := c.subscriptionEntry(entry)
+
+	if c.err != nil {
+		return c.err
+	}
+	return s.Nano_SubscribeServer.Send(converted)
+}
+
+func (v2 *ServerV2) Subscribe(request *pbv2.SubscribeRequest, stream pbv2.Nano_SubscribeServer) error {
+	minAmount, err := amountV1(request.MinAmount)
+	if err != nil {
+		return err
+	}
+
+	return v2.server.Subscribe(&pb.SubscribeRequest{
+		Accounts:   request.Accounts,
+		Match:      pb.AccountMatch(request.Match),
+		Subtypes:   request.Subtypes,
+		MinAmount:  minAmount,
+		Overflow:   pb.OverflowPolicy(request.Overflow),
+		ResumeFrom: request.ResumeFrom,
+	}, subscribeStreamV2{stream})
+}
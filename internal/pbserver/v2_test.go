@@ -0,0 +1,130 @@
+package pbserver
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/internal/usclient/mocks"
+	pb "github.com/alvistar/nanopb/nanoproto"
+	pbv2 "github.com/alvistar/nanopb/nanoproto/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestAmountV2(t *testing.T) {
+	amount, err := amountV2("340282366920938463463374607431768211455")
+	require.Nil(t, err)
+	assert.Equal(t, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, amount.Raw)
+
+	amount, err = amountV2("258")
+	require.Nil(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2}, amount.Raw)
+	assert.Equal(t, "258", amount.Decimal)
+
+	amount, err = amountV2("")
+	assert.Nil(t, err)
+	assert.Nil(t, amount)
+
+	_, err = amountV2("340282366920938463463374607431768211456")
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAmountV1(t *testing.T) {
+	raw := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2}
+
+	decimal, err := amountV1(&pbv2.Amount{Raw: raw})
+	require.Nil(t, err)
+	assert.Equal(t, "258", decimal)
+
+	decimal, err = amountV1(&pbv2.Amount{Raw: raw, Decimal: "258"})
+	require.Nil(t, err)
+	assert.Equal(t, "258", decimal)
+
+	decimal, err = amountV1(nil)
+	require.Nil(t, err)
+	assert.Equal(t, "", decimal)
+
+	_, err = amountV1(&pbv2.Amount{Raw: raw, Decimal: "259"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = amountV1(&pbv2.Amount{Raw: []byte{1, 2}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = amountV1(&pbv2.Amount{Decimal: "-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBlockInfoV2(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return(returned, nil)
+	var s = Server{usClient: &client}
+
+	reply, err := s.V2().BlockInfo(context.Background(), &pbv2.BlockInfoRequest{Hash: "1234"})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(58), reply.Height)
+	assert.Equal(t, uint64(0), reply.LocalTimestamp)
+	assert.True(t, reply.Confirmed)
+	assert.Equal(t, "30000000000000000000000000000000000", reply.Amount.Decimal)
+	assert.Equal(t, 16, len(reply.Amount.Raw))
+	assert.Equal(t, "5606157000000000000000000000000000000", reply.Contents.Balance.Decimal)
+}
+
+func TestBlockInfoV2InvalidReply(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"height": "abc"}`), nil)
+	var s = Server{usClient: &client}
+
+	_, err := s.V2().BlockInfo(context.Background(), &pbv2.BlockInfoRequest{Hash: "1234"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestSendV2(t *testing.T) {
+	client := mocks.IUSClient{}
+
+	client.On("Get", mock.Anything, mock.Anything).Return([]byte(`{"block": "ABCD"}`), nil)
+	var s = Server{usClient: &client}
+
+	reply, err := s.V2().Send(context.Background(), &pbv2.SendRequest{
+		Wallet:      "W1",
+		Source:      "nano_a",
+		Destination: "nano_b",
+		Amount:      &pbv2.Amount{Decimal: "1000"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "ABCD", reply.Block)
+
+	expected := `{"action":"send", "wallet":"W1", "source":"nano_a", "destination":"nano_b", "amount":"1000"}`
+	client.AssertCalled(t, "Get", mock.Anything, jsonMatch(t, expected))
+}
+
+func TestSubscriptionEntryV2(t *testing.T) {
+	c := converter{}
+	entry := c.subscriptionEntry(&pb.SubscriptionEntry{
+		Topic:    "confirmation",
+		Time:     "1564935350664",
+		Sequence: 7,
+		Message: &pb.SubscriptionMessage{
+			Amount:       "1000",
+			ElectionInfo: &pb.ElectionInfo{Duration: "546", Tally: "42", RequestCount: "1"},
+			Block:        &pb.SubscriptionBlock{Balance: "0", Subtype: "send"},
+		},
+	})
+
+	require.Nil(t, c.err)
+	assert.Equal(t, uint64(1564935350664), entry.Time)
+	assert.Equal(t, uint64(7), entry.Sequence)
+	assert.Equal(t, "1000", entry.Message.Amount.Decimal)
+	assert.Equal(t, uint64(546), entry.Message.ElectionInfo.Duration)
+	assert.Equal(t, "42", entry.Message.ElectionInfo.Tally.Decimal)
+	assert.Equal(t, "0", entry.Message.Block.Balance.Decimal)
+
+	status := c.subscriptionEntry(&pb.SubscriptionEntry{Topic: "status", Status: pb.ConnectionStatus_RECONNECTING})
+	require.Nil(t, c.err)
+	assert.Equal(t, pbv2.ConnectionStatus_RECONNECTING, status.Status)
+	assert.Nil(t, status.Message)
+}
@@ -0,0 +1,64 @@
+// Package recordio frames the records of the append only files of the
+// gateway with their length and checksum, so that a torn or corrupted tail is
+// detected when the file is reopened.
+package recordio
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+// HeaderSize is the size of the record header: payload length and CRC32 of
+// the payload
+const HeaderSize = 8
+
+// MaxSize bounds the payload of a record, so that a corrupted length does not
+// allocate gigabytes
+const MaxSize = 1 << 20
+
+// ErrCorrupted is returned by Read when the payload does not match its
+// checksum or its length exceeds MaxSize
+var ErrCorrupted = errors.New("corrupted record")
+
+// ErrTooLarge is returned by Frame when the payload exceeds MaxSize
+var ErrTooLarge = errors.New("record too large")
+
+// Frame returns data preceded by its header
+func Frame(data []byte) ([]byte, error) {
+	if len(data) > MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	buf := make([]byte, HeaderSize+len(data))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(data))
+	copy(buf[HeaderSize:], data)
+	return buf, nil
+}
+
+// Read returns the payload of the next record of r. A record cut short
+// returns io.EOF or io.ErrUnexpectedEOF.
+func Read(r io.Reader) ([]byte, error) {
+	var header [HeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size > MaxSize {
+		return nil, ErrCorrupted
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, ErrCorrupted
+	}
+
+	return data, nil
+}
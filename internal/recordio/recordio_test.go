@@ -0,0 +1,60 @@
+package recordio
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+)
+
+func frame(t *testing.T, payload string) []byte {
+	framed, err := Frame([]byte(payload))
+	require.Nil(t, err)
+	return framed
+}
+
+func TestFrameRead(t *testing.T) {
+	buf := bytes.Buffer{}
+	buf.Write(frame(t, "first"))
+	buf.Write(frame(t, ""))
+	buf.Write(frame(t, "third"))
+
+	for _, expected := range []string{"first", "", "third"} {
+		data, err := Read(&buf)
+		require.Nil(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+
+	_, err := Read(&buf)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReadTorn(t *testing.T) {
+	framed := frame(t, "payload")
+
+	_, err := Read(bytes.NewReader(framed[:HeaderSize-2]))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	_, err = Read(bytes.NewReader(framed[:len(framed)-1]))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestReadCorrupted(t *testing.T) {
+	framed := frame(t, "payload")
+	framed[HeaderSize] ^= 0xff
+
+	_, err := Read(bytes.NewReader(framed))
+	assert.Equal(t, ErrCorrupted, err)
+
+	// A corrupted length is not trusted
+	framed = frame(t, "payload")
+	framed[0] = 0xff
+	_, err = Read(bytes.NewReader(framed))
+	assert.Equal(t, ErrCorrupted, err)
+}
+
+func TestFrameTooLarge(t *testing.T) {
+	_, err := Frame(make([]byte, MaxSize+1))
+	assert.Equal(t, ErrTooLarge, err)
+}
@@ -0,0 +1,163 @@
+package sendlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/alvistar/nanopb/internal/recordio"
+	"io"
+	"os"
+	"sync"
+)
+
+// A Record is a send completed by the node
+type Record struct {
+	ID          string `json:"id"`
+	Wallet      string `json:"wallet"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Amount      string `json:"amount"`
+	Block       string `json:"block"`
+}
+
+// Same reports whether other requests the same send as r
+func (r *Record) Same(other *Record) bool {
+	return r.ID == other.ID && r.Wallet == other.Wallet && r.Source == other.Source &&
+		r.Destination == other.Destination && r.Amount == other.Amount
+}
+
+// file is the part of *os.File used by the log
+type file interface {
+	io.WriteSeeker
+	io.Closer
+	Sync() error
+	Truncate(size int64) error
+}
+
+// A Log is an append only file of the sends with an id, indexed in memory
+type Log struct {
+	mutex sync.Mutex
+	file  file
+	size  int64
+	// Set when a failed write could not be rolled back, the log refuses
+	// further writes
+	err     error
+	records map[string]*Record
+}
+
+// Open opens or creates the log at path, loading its records. A partially
+// written last record is truncated.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Log{file: f, records: map[string]*Record{}}
+
+	var valid int64
+	for {
+		data, err := recordio.Read(f)
+		if err != nil {
+			break
+		}
+
+		record := Record{}
+		if err := json.Unmarshal(data, &record); err != nil {
+			break
+		}
+
+		l.records[record.ID] = &record
+		valid += int64(recordio.HeaderSize + len(data))
+	}
+
+	if err := f.Truncate(valid); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(valid, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	l.size = valid
+
+	return l, nil
+}
+
+// Get returns the record of id
+func (l *Log) Get(id string) (*Record, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	record, ok := l.records[id]
+	return record, ok
+}
+
+// Put stores record and syncs it to disk. A failed write is truncated so that
+// the following records stay readable.
+func (l *Log) Put(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	buf, err := recordio.Frame(data)
+	if err != nil {
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.file == nil {
+		return errors.New("send log closed")
+	}
+
+	if l.err != nil {
+		return l.err
+	}
+
+	if _, err := l.file.Write(buf); err != nil {
+		l.rollback()
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		l.rollback()
+		return err
+	}
+
+	l.size += int64(len(buf))
+	l.records[record.ID] = record
+	return nil
+}
+
+// rollback removes what a failed Put wrote after the last record
+func (l *Log) rollback() {
+	if err := l.file.Truncate(l.size); err != nil {
+		l.err = fmt.Errorf("send log failed: %s", err)
+		return
+	}
+	if _, err := l.file.Seek(l.size, io.SeekStart); err != nil {
+		l.err = fmt.Errorf("send log failed: %s", err)
+	}
+}
+
+// Len returns the number of records
+func (l *Log) Len() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return len(l.records)
+}
+
+// Close closes the log file
+func (l *Log) Close() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
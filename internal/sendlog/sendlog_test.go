@@ -0,0 +1,117 @@
+package sendlog
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func tempLog(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "sendlog")
+	require.Nil(t, err)
+	return filepath.Join(dir, "sends.log")
+}
+
+func TestPutReopen(t *testing.T) {
+	path := tempLog(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	l, err := Open(path)
+	require.Nil(t, err)
+
+	record := Record{ID: "1", Wallet: "W1", Source: "nano_a", Destination: "nano_b", Amount: "10", Block: "B1"}
+	require.Nil(t, l.Put(&record))
+	require.Nil(t, l.Put(&Record{ID: "2", Block: "B2"}))
+	require.Nil(t, l.Close())
+
+	l, err = Open(path)
+	require.Nil(t, err)
+	defer l.Close()
+
+	assert.Equal(t, 2, l.Len())
+	stored, ok := l.Get("1")
+	require.True(t, ok)
+	assert.Equal(t, record, *stored)
+
+	_, ok = l.Get("3")
+	assert.False(t, ok)
+}
+
+func TestPartialRecord(t *testing.T) {
+	path := tempLog(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	l, err := Open(path)
+	require.Nil(t, err)
+	require.Nil(t, l.Put(&Record{ID: "1", Block: "B1"}))
+	require.Nil(t, l.Put(&Record{ID: "2", Block: "B2"}))
+	require.Nil(t, l.Close())
+
+	info, err := os.Stat(path)
+	require.Nil(t, err)
+	require.Nil(t, os.Truncate(path, info.Size()-3))
+
+	l, err = Open(path)
+	require.Nil(t, err)
+	assert.Equal(t, 1, l.Len())
+
+	// Appending continues after the last valid record
+	require.Nil(t, l.Put(&Record{ID: "3", Block: "B3"}))
+	require.Nil(t, l.Close())
+
+	l, err = Open(path)
+	require.Nil(t, err)
+	defer l.Close()
+
+	assert.Equal(t, 2, l.Len())
+	_, ok := l.Get("3")
+	assert.True(t, ok)
+}
+
+// shortWriter writes half of the data then fails
+type shortWriter struct {
+	file
+}
+
+func (w shortWriter) Write(data []byte) (int, error) {
+	n, _ := w.file.Write(data[:len(data)/2])
+	return n, errors.New("disk full")
+}
+
+func TestFailedWrite(t *testing.T) {
+	path := tempLog(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	l, err := Open(path)
+	require.Nil(t, err)
+	require.Nil(t, l.Put(&Record{ID: "1", Block: "B1"}))
+
+	f := l.file
+	l.file = shortWriter{f}
+	assert.NotNil(t, l.Put(&Record{ID: "2", Block: "B2"}))
+	_, ok := l.Get("2")
+	assert.False(t, ok)
+
+	l.file = f
+	require.Nil(t, l.Put(&Record{ID: "3", Block: "B3"}))
+	require.Nil(t, l.Close())
+
+	l, err = Open(path)
+	require.Nil(t, err)
+	defer l.Close()
+
+	assert.Equal(t, 2, l.Len())
+	_, ok = l.Get("3")
+	assert.True(t, ok)
+}
+
+func TestSame(t *testing.T) {
+	a := Record{ID: "1", Wallet: "W1", Amount: "10", Block: "B1"}
+
+	assert.True(t, a.Same(&Record{ID: "1", Wallet: "W1", Amount: "10"}))
+	assert.False(t, a.Same(&Record{ID: "1", Wallet: "W1", Amount: "11"}))
+}
@@ -0,0 +1,173 @@
+package usclient
+
+import (
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"sync"
+	"time"
+)
+
+// States of a circuit breaker
+const (
+	// Requests go through
+	BreakerClosed = "closed"
+	// Requests fail fast
+	BreakerOpen = "open"
+	// A single trial request goes through to probe recovery
+	BreakerHalfOpen = "half-open"
+)
+
+// ConfBreaker configures the circuit breaker of each node
+type ConfBreaker struct {
+	// Consecutive failed or slow requests opening the breaker. Default is 5.
+	Failures int `json:"failures"`
+	// Milliseconds after which a request counts as failed, not counting the
+	// wait for a session. Requests generating work are exempt. Default is 5000.
+	SlowCall int `json:"slowcall"`
+	// Seconds an open breaker waits before a trial request. Default is 10.
+	OpenTime int `json:"opentime"`
+}
+
+// workActions may generate the work of a block, which takes longer than any
+// other request. They are exempt from the slow call rule.
+var workActions = map[string]bool{
+	"send":          true,
+	"receive":       true,
+	"block_create":  true,
+	"work_generate": true,
+}
+
+// breaker stops sending requests to a node failing consecutively, so callers
+// fail fast instead of queueing on its sessions
+type breaker struct {
+	connection string
+	failures   int
+	slowCall   time.Duration
+	openTime   time.Duration
+
+	mutex    sync.Mutex
+	state    string
+	failed   int
+	openedAt time.Time
+	trial    bool
+}
+
+func newBreaker(connection string, conf ConfBreaker) *breaker {
+	b := breaker{
+		connection: connection,
+		failures:   conf.Failures,
+		slowCall:   time.Duration(conf.SlowCall) * time.Millisecond,
+		openTime:   time.Duration(conf.OpenTime) * time.Second,
+		state:      BreakerClosed,
+	}
+
+	if b.failures <= 0 {
+		b.failures = 5
+	}
+	if b.slowCall <= 0 {
+		b.slowCall = 5 * time.Second
+	}
+	if b.openTime <= 0 {
+		b.openTime = 10 * time.Second
+	}
+
+	return &b
+}
+
+// allow reports whether a request may be sent, turning an open breaker half
+// open once openTime has passed
+func (b *breaker) allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.openTime {
+			return false
+		}
+		b.transition(BreakerHalfOpen)
+		b.trial = true
+		return true
+	case BreakerHalfOpen:
+		if b.trial {
+			return false
+		}
+		b.trial = true
+		return true
+	}
+
+	return true
+}
+
+// isOpen reports whether requests would fail fast
+func (b *breaker) isOpen() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.state == BreakerOpen && time.Since(b.openedAt) < b.openTime
+}
+
+// record updates the breaker with the outcome of an allowed request
+func (b *breaker) record(err *nanoipc.Error, latency time.Duration) {
+	failed := latency > b.slowCall || (err != nil && (err.Category == "Connection" || err.Category == "Network"))
+	// A request cancelled by the caller, or that found no idle session of the
+	// gateway, says nothing about the node unless slow
+	inconclusive := !failed && err != nil && (err.Category == "Context" || err.Category == "Pool")
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.state == BreakerHalfOpen {
+		b.trial = false
+		if inconclusive {
+			// The next request is the trial
+			return
+		}
+		if failed {
+			b.open()
+		} else {
+			b.failed = 0
+			b.transition(BreakerClosed)
+		}
+		return
+	}
+
+	if inconclusive {
+		return
+	}
+
+	if !failed {
+		b.failed = 0
+		return
+	}
+
+	b.failed++
+	if b.state == BreakerClosed && b.failed >= b.failures {
+		b.open()
+	}
+}
+
+// open opens the breaker. Must be called with mutex held.
+func (b *breaker) open() {
+	b.openedAt = time.Now()
+	b.transition(BreakerOpen)
+}
+
+// transition logs and applies a state change. Must be called with mutex held.
+func (b *breaker) transition(state string) {
+	if state == b.state {
+		return
+	}
+
+	if state == BreakerOpen {
+		logger.Warnf("Circuit breaker of %s open after %d failures", b.connection, b.failed)
+	} else {
+		logger.Infof("Circuit breaker of %s %s", b.connection, state)
+	}
+	b.state = state
+}
+
+// status returns the state and the consecutive failures
+func (b *breaker) status() (string, int) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.state, b.failed
+}
@@ -0,0 +1,145 @@
+package usclient
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestBreakerOpensAfterFailures(t *testing.T) {
+	b := newBreaker("node", ConfBreaker{Failures: 3, SlowCall: 100, OpenTime: 3600})
+	networkErr := &nanoipc.Error{Code: 1, Message: "broken pipe", Category: "Network"}
+
+	for i := 0; i < 2; i++ {
+		require.True(t, b.allow())
+		b.record(networkErr, time.Millisecond)
+	}
+
+	// A success resets the count
+	b.record(nil, time.Millisecond)
+	state, failures := b.status()
+	assert.Equal(t, BreakerClosed, state)
+	assert.Equal(t, 0, failures)
+
+	// Node errors and cancellations say nothing about the node health
+	b.record(&nanoipc.Error{Code: 1, Message: "Account not found", Category: "Node"}, time.Millisecond)
+	b.record(&nanoipc.Error{Code: 1, Message: "context canceled", Category: "Context"}, time.Millisecond)
+	_, failures = b.status()
+	assert.Equal(t, 0, failures)
+
+	// Neither does running out of sessions in the gateway
+	b.record(networkErr, time.Millisecond)
+	b.record(&nanoipc.Error{Code: 1, Message: "no idle IPC session", Category: "Pool"}, 0)
+	_, failures = b.status()
+	assert.Equal(t, 1, failures)
+	b.record(nil, time.Millisecond)
+
+	b.record(networkErr, time.Millisecond)
+	b.record(nil, 200*time.Millisecond)
+	b.record(networkErr, time.Millisecond)
+
+	state, failures = b.status()
+	assert.Equal(t, BreakerOpen, state)
+	assert.Equal(t, 3, failures)
+	assert.True(t, b.isOpen())
+	assert.False(t, b.allow())
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	b := newBreaker("node", ConfBreaker{Failures: 1, OpenTime: 3600})
+	b.record(&nanoipc.Error{Code: 1, Message: "not connected", Category: "Connection"}, time.Millisecond)
+	require.True(t, b.isOpen())
+
+	// Once openTime has passed a single trial goes through
+	b.openedAt = time.Now().Add(-time.Hour)
+	assert.False(t, b.isOpen())
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+
+	state, _ := b.status()
+	assert.Equal(t, BreakerHalfOpen, state)
+
+	// A failed trial opens the breaker again
+	b.record(nil, time.Hour)
+	assert.True(t, b.isOpen())
+
+	b.openedAt = time.Now().Add(-time.Hour)
+	assert.True(t, b.allow())
+	b.record(nil, time.Millisecond)
+
+	state, failures := b.status()
+	assert.Equal(t, BreakerClosed, state)
+	assert.Equal(t, 0, failures)
+	assert.True(t, b.allow())
+}
+
+func TestBreakerFailsFast(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := USClient{}
+	client.Init(&ConfNode{
+		Connection:    node.uri(),
+		PoolSize:      1,
+		ProbeInterval: 3600,
+		Breaker:       ConfBreaker{Failures: 1, SlowCall: 50, OpenTime: 3600},
+	}, nil)
+	defer client.Close()
+
+	// A slow call opens the breaker
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	_, _ = client.Get(ctx, []byte(`{"action":"stall"}`))
+	cancel()
+
+	start := time.Now()
+	_, err := client.Get(context.Background(), []byte(`{"action":"block_count"}`))
+	require.NotNil(t, err)
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+
+	status := client.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, node.uri(), status[0].Connection)
+	assert.Equal(t, RolePrimary, status[0].Role)
+	assert.Equal(t, BreakerOpen, status[0].Breaker)
+	assert.Equal(t, 1, status[0].Failures)
+}
+
+func TestBreakerIgnoresPoolWaitAndWork(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := USClient{}
+	client.Init(&ConfNode{
+		Connection:    node.uri(),
+		PoolSize:      1,
+		ProbeInterval: 3600,
+		Breaker:       ConfBreaker{Failures: 1, SlowCall: 150, OpenTime: 3600},
+	}, nil)
+	defer client.Close()
+
+	// A send waits for the work of its block
+	_, err := client.Get(context.Background(), []byte(`{"action":"send"}`))
+	require.Nil(t, err)
+	assert.Equal(t, BreakerClosed, client.Status()[0].Breaker)
+
+	// The wait for the session held by the send is not node latency
+	done := make(chan struct{})
+	go func() {
+		_, _ = client.Get(context.Background(), []byte(`{"action":"send"}`))
+		close(done)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	start := time.Now()
+	_, err = client.Get(context.Background(), []byte(`{"action":"version"}`))
+	require.Nil(t, err)
+	assert.True(t, time.Since(start) > 150*time.Millisecond)
+	<-done
+
+	status := client.Status()
+	assert.Equal(t, BreakerClosed, status[0].Breaker)
+	assert.Equal(t, 0, status[0].Failures)
+}
@@ -3,9 +3,15 @@
 package mocks
 
 import (
+	context "context"
+
 	logrus "github.com/sirupsen/logrus"
 	mock "github.com/stretchr/testify/mock"
 
+	nanoapi "github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
+
+	nanoipc "github.com/alvistar/nanopb/pkg/nanoipc"
+
 	usclient "github.com/alvistar/nanopb/internal/usclient"
 )
 
@@ -14,13 +20,13 @@ type IUSClient struct {
 	mock.Mock
 }
 
-// Get provides a mock function with given fields: request
-func (_m *IUSClient) Get(request []byte) ([]byte, error) {
-	ret := _m.Called(request)
+// Get provides a mock function with given fields: ctx, request
+func (_m *IUSClient) Get(ctx context.Context, request []byte) ([]byte, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) []byte); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -28,8 +34,31 @@ func (_m *IUSClient) Get(request []byte) ([]byte, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessage provides a mock function with given fields: ctx, message
+func (_m *IUSClient) GetMessage(ctx context.Context, message nanoipc.Message) (*nanoapi.Envelope, error) {
+	ret := _m.Called(ctx, message)
+
+	var r0 *nanoapi.Envelope
+	if rf, ok := ret.Get(0).(func(context.Context, nanoipc.Message) *nanoapi.Envelope); ok {
+		r0 = rf(ctx, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*nanoapi.Envelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, nanoipc.Message) error); ok {
+		r1 = rf(ctx, message)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,3 +70,19 @@ func (_m *IUSClient) Get(request []byte) ([]byte, error) {
 func (_m *IUSClient) Init(conf *usclient.ConfNode, l *logrus.Logger) {
 	_m.Called(conf, l)
 }
+
+// Status provides a mock function with given fields:
+func (_m *IUSClient) Status() []usclient.NodeStatus {
+	ret := _m.Called()
+
+	var r0 []usclient.NodeStatus
+	if rf, ok := ret.Get(0).(func() []usclient.NodeStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]usclient.NodeStatus)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,340 @@
+package usclient
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Roles of ConfEndpoint
+const (
+	// The node whose wallets are used, it receives the wallet actions
+	RolePrimary = "primary"
+	// A node serving read-only actions
+	RoleSecondary = "secondary"
+)
+
+// ConfEndpoint is one of the nodes of ConfNode.Nodes
+type ConfEndpoint struct {
+	Connection string `json:"connection"`
+	// Share of the read-only requests among the healthy nodes. Default is 1.
+	Weight int `json:"weight"`
+	// RolePrimary or RoleSecondary
+	Role string `json:"role"`
+}
+
+// primaryActions read or modify the wallets of the node, besides the wallet_ ones
+var primaryActions = map[string]bool{
+	"send":                       true,
+	"receive":                    true,
+	"account_create":             true,
+	"accounts_create":            true,
+	"account_list":               true,
+	"account_move":               true,
+	"account_remove":             true,
+	"account_representative_set": true,
+	"receive_minimum":            true,
+	"receive_minimum_set":        true,
+	"search_pending":             true,
+	"search_pending_all":         true,
+	"search_receivable":          true,
+	"search_receivable_all":      true,
+	"password_change":            true,
+	"password_enter":             true,
+	"password_valid":             true,
+}
+
+// isPrimaryAction reports whether action must be sent to the primary node
+func isPrimaryAction(action string) bool {
+	return strings.HasPrefix(action, "wallet_") || primaryActions[action]
+}
+
+// requestFields returns the action and the id of a JSON request
+func requestFields(request []byte) (action string, id string) {
+	fields := struct {
+		Action string `json:"action"`
+		ID     string `json:"id"`
+	}{}
+	_ = json.Unmarshal(request, &fields)
+	return fields.Action, fields.ID
+}
+
+// endpoint is a node with its session pool and the result of the last probe
+type endpoint struct {
+	conf    ConfEndpoint
+	weight  int
+	pool    *pool
+	breaker *breaker
+	// Session used by the probes only, so busy pools do not fail them
+	probeSession *nanoipc.Session
+
+	// Guarded by USClient.mutex
+	healthy bool
+	synced  bool
+	count   uint64
+	version string
+}
+
+func newEndpoint(conf ConfEndpoint, size int, waitTimeout time.Duration, breakerConf ConfBreaker) *endpoint {
+	e := endpoint{
+		conf:         conf,
+		weight:       conf.Weight,
+		pool:         newPool(conf.Connection, size, waitTimeout),
+		breaker:      newBreaker(conf.Connection, breakerConf),
+		probeSession: &nanoipc.Session{},
+	}
+
+	if e.weight <= 0 {
+		e.weight = 1
+	}
+
+	// Trusted until the first probe
+	e.healthy, e.synced = true, true
+
+	return &e
+}
+
+// probe requests block_count and version from the node, connecting first if
+// needed, all within timeout
+func (e *endpoint) probe(timeout time.Duration) (count uint64, version string, err *nanoipc.Error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if !e.probeSession.Connected {
+		if err := e.probeSession.ConnectContext(ctx, e.conf.Connection); err != nil {
+			return 0, "", err
+		}
+	}
+
+	reply, err := e.probeSession.RequestContext(ctx, `{"action":"block_count"}`)
+	if err != nil {
+		_ = e.probeSession.Close()
+		return 0, "", err
+	}
+
+	blockCount := struct {
+		Count string `json:"count"`
+	}{}
+	if jerr := json.Unmarshal(reply, &blockCount); jerr != nil {
+		return 0, "", &nanoipc.Error{Code: 1, Message: "invalid block_count reply", Category: "Node"}
+	}
+	count, perr := strconv.ParseUint(blockCount.Count, 10, 64)
+	if perr != nil {
+		return 0, "", &nanoipc.Error{Code: 1, Message: "invalid block_count reply", Category: "Node"}
+	}
+
+	reply, err = e.probeSession.RequestContext(ctx, `{"action":"version"}`)
+	if err != nil {
+		_ = e.probeSession.Close()
+		return 0, "", err
+	}
+
+	nodeVersion := struct {
+		NodeVendor string `json:"node_vendor"`
+	}{}
+	_ = json.Unmarshal(reply, &nodeVersion)
+
+	return count, nodeVersion.NodeVendor, nil
+}
+
+func (e *endpoint) available() bool {
+	return e.healthy && e.synced && !e.breaker.isOpen()
+}
+
+// NodeStatus is the state of a node as seen by the client
+type NodeStatus struct {
+	Connection string
+	Role       string
+	// Passed the last probe
+	Healthy bool
+	// Within MaxBehind blocks of the other nodes
+	Synced     bool
+	BlockCount uint64
+	Version    string
+	// State of the circuit breaker and its consecutive failures
+	Breaker  string
+	Failures int
+}
+
+// Status returns the state of every node
+func (client *USClient) Status() []NodeStatus {
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+
+	status := make([]NodeStatus, 0, len(client.endpoints))
+	for _, e := range client.endpoints {
+		role := RoleSecondary
+		if e == client.primary {
+			role = RolePrimary
+		}
+
+		breaker, failures := e.breaker.status()
+
+		status = append(status, NodeStatus{
+			Connection: e.conf.Connection,
+			Role:       role,
+			Healthy:    e.healthy,
+			Synced:     e.synced,
+			BlockCount: e.count,
+			Version:    e.version,
+			Breaker:    breaker,
+			Failures:   failures,
+		})
+	}
+
+	return status
+}
+
+// endpoints returns the nodes of conf, the single Connection if Nodes is empty
+func (conf *ConfNode) endpoints() []ConfEndpoint {
+	if len(conf.Nodes) == 0 {
+		return []ConfEndpoint{{Connection: conf.Connection, Role: RolePrimary}}
+	}
+	return conf.Nodes
+}
+
+type primaryKey struct{}
+
+// WithPrimary returns a context sending the requests made with it to the
+// primary node, for replies that must not lag behind its confirmations
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// route returns the node for action: the primary for wallet actions and the
+// requests made WithPrimary, otherwise one of the healthy and synced nodes
+// picked by weight
+func (client *USClient) route(ctx context.Context, action string) *endpoint {
+	if primary, _ := ctx.Value(primaryKey{}).(bool); primary || isPrimaryAction(action) {
+		return client.primary
+	}
+
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+
+	total := 0
+	for _, e := range client.endpoints {
+		if e.available() {
+			total += e.weight
+		}
+	}
+
+	if total == 0 {
+		return client.primary
+	}
+
+	n := int(atomic.AddUint64(&client.next, 1) % uint64(total))
+	for _, e := range client.endpoints {
+		if !e.available() {
+			continue
+		}
+		if n < e.weight {
+			return e
+		}
+		n -= e.weight
+	}
+
+	return client.primary
+}
+
+// markDown excludes a node from the reads until the next successful probe
+func (client *USClient) markDown(e *endpoint, err *nanoipc.Error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if e.healthy {
+		logger.Warnf("Node %s down: %s", e.conf.Connection, err)
+	}
+	e.healthy = false
+}
+
+// probeAll probes every node and updates their health and sync state. Nodes
+// more than MaxBehind blocks behind the highest count are not synced.
+func (client *USClient) probeAll() {
+	client.probeMutex.Lock()
+	defer client.probeMutex.Unlock()
+
+	type result struct {
+		count   uint64
+		version string
+		err     *nanoipc.Error
+	}
+
+	results := make([]result, len(client.endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range client.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			r := &results[i]
+			r.count, r.version, r.err = e.probe(client.probeTimeout)
+		}(i, e)
+	}
+	wg.Wait()
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	var highest uint64
+	for _, r := range results {
+		if r.err == nil && r.count > highest {
+			highest = r.count
+		}
+	}
+
+	for i, e := range client.endpoints {
+		r := results[i]
+
+		if r.err != nil {
+			if e.healthy {
+				logger.Warnf("Node %s down: %s", e.conf.Connection, r.err)
+			}
+			e.healthy = false
+			continue
+		}
+
+		if !e.healthy {
+			logger.Infof("Node %s up", e.conf.Connection)
+		}
+		if r.version != e.version {
+			logger.Infof("Node %s version %s", e.conf.Connection, r.version)
+		}
+
+		synced := r.count+client.maxBehind >= highest
+		if synced != e.synced {
+			logger.Infof("Node %s synced %t, %d blocks of %d", e.conf.Connection, synced, r.count, highest)
+		}
+
+		e.healthy, e.synced, e.count, e.version = true, synced, r.count, r.version
+	}
+}
+
+// probeLoop probes the nodes every interval until the client is closed
+func (client *USClient) probeLoop(interval time.Duration) {
+	defer close(client.probeDone)
+
+	client.probeAll()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			client.probeAll()
+		case <-client.closing:
+			client.probeMutex.Lock()
+			defer client.probeMutex.Unlock()
+			for _, e := range client.endpoints {
+				_ = e.probeSession.Close()
+			}
+			return
+		}
+	}
+}
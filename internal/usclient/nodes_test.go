@@ -0,0 +1,126 @@
+package usclient
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIsPrimaryAction(t *testing.T) {
+	action, id := requestFields([]byte(`{"action":"send","wallet":"W1","id":"tx1"}`))
+	assert.True(t, isPrimaryAction(action))
+	assert.Equal(t, "tx1", id)
+
+	action, _ = requestFields([]byte(`{"action":"block_info"}`))
+	assert.False(t, isPrimaryAction(action))
+	assert.True(t, isPrimaryAction("wallet_balances"))
+	assert.False(t, isPrimaryAction(""))
+}
+
+func TestRoute(t *testing.T) {
+	primary := &endpoint{weight: 1, healthy: true, synced: true, breaker: newBreaker("primary", ConfBreaker{})}
+	secondary := &endpoint{weight: 2, healthy: true, synced: true, breaker: newBreaker("secondary", ConfBreaker{})}
+
+	client := USClient{endpoints: []*endpoint{primary, secondary}, primary: primary}
+
+	routed := map[*endpoint]int{}
+	for i := 0; i < 300; i++ {
+		routed[client.route(context.Background(), "block_info")]++
+	}
+	assert.Equal(t, 100, routed[primary])
+	assert.Equal(t, 200, routed[secondary])
+
+	primary.synced = false
+	for i := 0; i < 10; i++ {
+		assert.True(t, client.route(context.Background(), "block_info") == secondary)
+		assert.True(t, client.route(context.Background(), "send") == primary)
+	}
+
+	primary.synced = true
+	for i := 0; i < 10; i++ {
+		assert.True(t, client.route(WithPrimary(context.Background()), "block_info") == primary)
+	}
+
+	// Without available nodes the reads try the primary
+	secondary.healthy = false
+	assert.True(t, client.route(context.Background(), "block_info") == primary)
+}
+
+func TestProbeAll(t *testing.T) {
+	ahead := newFakeNode(t)
+	defer ahead.close()
+	behind := newFakeNode(t)
+	defer behind.close()
+
+	atomic.StoreUint64(&ahead.count, 5000)
+	atomic.StoreUint64(&behind.count, 3000)
+
+	client := USClient{}
+	client.Init(&ConfNode{
+		PoolSize:      1,
+		ProbeInterval: 3600,
+		Nodes: []ConfEndpoint{
+			{Connection: ahead.uri(), Role: RolePrimary},
+			{Connection: behind.uri(), Role: RoleSecondary},
+			{Connection: "local:///nonexistent/node", Role: RoleSecondary},
+		},
+	}, nil)
+	defer client.Close()
+
+	client.probeAll()
+
+	client.mutex.RLock()
+	assert.True(t, client.endpoints[0].available())
+	assert.Equal(t, "Nano V21.3", client.endpoints[0].version)
+	assert.True(t, client.endpoints[1].healthy)
+	assert.False(t, client.endpoints[1].synced)
+	assert.False(t, client.endpoints[2].healthy)
+	client.mutex.RUnlock()
+
+	atomic.StoreUint64(&behind.count, 4500)
+	client.probeAll()
+
+	client.mutex.RLock()
+	assert.True(t, client.endpoints[1].available())
+	client.mutex.RUnlock()
+}
+
+func TestFailover(t *testing.T) {
+	primary := newFakeNode(t)
+	defer primary.close()
+	secondary := newFakeNode(t)
+
+	client := USClient{}
+	client.Init(&ConfNode{
+		PoolSize:      1,
+		WaitTimeout:   1,
+		ProbeInterval: 3600,
+		Nodes: []ConfEndpoint{
+			{Connection: primary.uri(), Role: RolePrimary},
+			{Connection: secondary.uri(), Role: RoleSecondary},
+		},
+	}, nil)
+	defer client.Close()
+
+	secondary.close()
+
+	// At most one read reaches the dead node before it is excluded
+	failures := 0
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := client.Get(ctx, []byte(`{"action":"block_info"}`))
+		cancel()
+		if err != nil {
+			failures++
+		}
+	}
+	assert.True(t, failures <= 1)
+
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+	require.Equal(t, 2, len(client.endpoints))
+	assert.False(t, client.endpoints[1].healthy)
+}
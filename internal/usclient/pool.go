@@ -0,0 +1,166 @@
+package usclient
+
+import (
+	"context"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"sync"
+	"time"
+)
+
+// Request sent by the health check to idle sessions
+const healthRequest = `{"action":"version"}`
+
+// A pool lends each of its sessions to one caller at a time. Broken sessions
+// are replaced one by one, when checked out or by the health check.
+type pool struct {
+	connection    string
+	waitTimeout   time.Duration
+	healthTimeout time.Duration
+
+	idle      chan *nanoipc.Session
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func newPool(connection string, size int, waitTimeout time.Duration) *pool {
+	p := &pool{
+		connection:    connection,
+		waitTimeout:   waitTimeout,
+		healthTimeout: 5 * time.Second,
+		idle:          make(chan *nanoipc.Session, size),
+		closing:       make(chan struct{}),
+	}
+
+	for i := 0; i < size; i++ {
+		session, _ := p.connect(context.Background())
+		p.idle <- session
+	}
+
+	return p
+}
+
+// connect returns a new session, left disconnected if the node cannot be
+// reached before the connection timeout or the end of ctx
+func (p *pool) connect(ctx context.Context) (*nanoipc.Session, *nanoipc.Error) {
+	session := &nanoipc.Session{}
+	err := session.ConnectContext(ctx, p.connection)
+	return session, err
+}
+
+// checkout takes an idle session, waiting up to waitTimeout when all of them
+// are busy. A disconnected session is replaced before being returned, the
+// reconnection being bounded by ctx.
+func (p *pool) checkout(ctx context.Context) (*nanoipc.Session, *nanoipc.Error) {
+	var session *nanoipc.Session
+
+	select {
+	case session = <-p.idle:
+	default:
+		timer := time.NewTimer(p.waitTimeout)
+		defer timer.Stop()
+
+		select {
+		case session = <-p.idle:
+		case <-timer.C:
+			return nil, &nanoipc.Error{Code: 1, Message: "no idle IPC session", Category: "Pool"}
+		case <-ctx.Done():
+			return nil, &nanoipc.Error{Code: 1, Message: ctx.Err().Error(), Category: "Context"}
+		}
+	}
+
+	if !session.Connected {
+		var err *nanoipc.Error
+		session, err = p.connect(ctx)
+		if !session.Connected {
+			p.idle <- session
+			if err != nil && err.Category == "Context" {
+				return nil, err
+			}
+			return nil, &nanoipc.Error{Code: 1, Message: "not connected to the node", Category: "Connection"}
+		}
+		logger.Info("Reconnected IPC session")
+	}
+
+	return session, nil
+}
+
+// checkin returns a session to the pool. A network error leaves the
+// connection in an unknown state, so the session is closed to be replaced.
+func (p *pool) checkin(session *nanoipc.Session, err *nanoipc.Error) {
+	select {
+	case <-p.closing:
+		_ = session.Close()
+		return
+	default:
+	}
+
+	if err != nil && err.Category == "Network" && session.Connected {
+		_ = session.Close()
+	}
+	p.idle <- session
+}
+
+// healthCheck probes the idle sessions every interval until the pool is closed
+func (p *pool) healthCheck(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.checkIdle()
+		case <-p.closing:
+			return
+		}
+	}
+}
+
+// checkIdle probes the sessions idle at the time of the check, replacing the
+// broken ones. Busy sessions are left to their callers.
+func (p *pool) checkIdle() {
+	for i := 0; i < cap(p.idle); i++ {
+		var session *nanoipc.Session
+
+		select {
+		case session = <-p.idle:
+		default:
+			return
+		}
+
+		if session.Connected {
+			ctx, cancel := context.WithTimeout(context.Background(), p.healthTimeout)
+			_, err := session.RequestContext(ctx, healthRequest)
+			cancel()
+
+			if err != nil {
+				logger.Warn("IPC session failed health check: ", err)
+				_ = session.Close()
+			}
+		}
+
+		if !session.Connected {
+			session, _ = p.connect(context.Background())
+			if session.Connected {
+				logger.Info("Replaced broken IPC session")
+			}
+		}
+
+		p.idle <- session
+	}
+}
+
+// close stops the health check and closes the idle sessions
+func (p *pool) close() {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+	})
+
+	for {
+		select {
+		case session := <-p.idle:
+			_ = session.Close()
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package usclient
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeNode is a local IPC server answering block_count and version, and
+// echoing the other JSON requests. Its connections can be dropped to
+// simulate a node restart.
+type fakeNode struct {
+	listener net.Listener
+	dir      string
+	// Block count returned by block_count
+	count uint64
+
+	mutex sync.Mutex
+	conns []net.Conn
+}
+
+func newFakeNode(t *testing.T) *fakeNode {
+	dir, err := ioutil.TempDir("", "usclient")
+	require.Nil(t, err)
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "node"))
+	require.Nil(t, err)
+
+	node := &fakeNode{listener: listener, dir: dir}
+	go node.serve()
+	return node
+}
+
+func (node *fakeNode) uri() string {
+	return "local://" + node.listener.Addr().String()
+}
+
+func (node *fakeNode) close() {
+	_ = node.listener.Close()
+	node.drop()
+	_ = os.RemoveAll(node.dir)
+}
+
+// waitConns waits until n connections have been accepted
+func (node *fakeNode) waitConns(t *testing.T, n int) {
+	for i := 0; i < 100; i++ {
+		node.mutex.Lock()
+		accepted := len(node.conns)
+		node.mutex.Unlock()
+
+		if accepted >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expecting %d connections", n)
+}
+
+// drop closes the open connections
+func (node *fakeNode) drop() {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	for _, conn := range node.conns {
+		_ = conn.Close()
+	}
+	node.conns = nil
+}
+
+func (node *fakeNode) serve() {
+	for {
+		conn, err := node.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		node.mutex.Lock()
+		node.conns = append(node.conns, conn)
+		node.mutex.Unlock()
+
+		go node.handle(conn)
+	}
+}
+
+func (node *fakeNode) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var preamble [4]byte
+		var bufLen [4]byte
+		if _, err := io.ReadFull(conn, preamble[:]); err != nil {
+			return
+		}
+		if _, err := io.ReadFull(conn, bufLen[:]); err != nil {
+			return
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(bufLen[:]))
+		if _, err := io.ReadFull(conn, payload); err != nil {
+			return
+		}
+
+		reply := payload
+		switch string(payload) {
+		case `{"action":"stall"}`, `{"action":"send"}`:
+			time.Sleep(200 * time.Millisecond)
+		case `{"action":"block_count"}`:
+			reply = []byte(fmt.Sprintf(`{"count":"%d"}`, atomic.LoadUint64(&node.count)))
+		case `{"action":"version"}`:
+			reply = []byte(`{"node_vendor":"Nano V21.3"}`)
+		}
+
+		binary.BigEndian.PutUint32(bufLen[:], uint32(len(reply)))
+		if _, err := conn.Write(bufLen[:]); err != nil {
+			return
+		}
+		if _, err := conn.Write(reply); err != nil {
+			return
+		}
+	}
+}
+
+func TestMain(m *testing.M) {
+	logger = log.NewEntry(log.New())
+	nanoipc.Init(nil)
+	os.Exit(m.Run())
+}
+
+func TestGet(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := USClient{}
+	client.Init(&ConfNode{Connection: node.uri(), PoolSize: 2}, nil)
+	defer client.Close()
+
+	reply, err := client.Get(context.Background(), []byte(`{"action":"account_balance"}`))
+	require.Nil(t, err)
+	assert.Equal(t, `{"action":"account_balance"}`, string(reply))
+}
+
+func TestCheckoutWaits(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	p := newPool(node.uri(), 1, 50*time.Millisecond)
+	defer p.close()
+
+	session, err := p.checkout(context.Background())
+	require.Nil(t, err)
+
+	// The only session is busy
+	_, err = p.checkout(context.Background())
+	require.NotNil(t, err)
+	assert.Equal(t, "Pool", err.Category)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p.waitTimeout = time.Second
+	_, err = p.checkout(ctx)
+	require.NotNil(t, err)
+	assert.Equal(t, "Context", err.Category)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		p.checkin(session, nil)
+	}()
+
+	again, err := p.checkout(context.Background())
+	require.Nil(t, err)
+	assert.True(t, again == session)
+}
+
+func TestSlowCallDoesNotBlockPool(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := USClient{}
+	client.Init(&ConfNode{Connection: node.uri(), PoolSize: 2, WaitTimeout: 1}, nil)
+	defer client.Close()
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = client.Get(context.Background(), []byte(`{"action":"stall"}`))
+		close(done)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	start := time.Now()
+	_, err := client.Get(context.Background(), []byte(`{"action":"version"}`))
+	require.Nil(t, err)
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+
+	<-done
+}
+
+func TestReplaceBrokenSessions(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	p := newPool(node.uri(), 2, time.Second)
+	defer p.close()
+	node.waitConns(t, 2)
+
+	first, err := p.checkout(context.Background())
+	require.Nil(t, err)
+
+	node.drop()
+
+	// The health check replaces the idle session only
+	p.checkIdle()
+	idle := <-p.idle
+	assert.True(t, idle.Connected)
+	_, err = idle.Request(`{"action":"version"}`)
+	assert.Nil(t, err)
+	p.checkin(idle, nil)
+
+	// A network error on the busy session closes it, and it is replaced on checkout
+	_, err = first.Request(`{"action":"version"}`)
+	require.NotNil(t, err)
+	p.checkin(first, err)
+	assert.False(t, first.Connected)
+
+	for i := 0; i < 2; i++ {
+		session, err := p.checkout(context.Background())
+		require.Nil(t, err)
+		assert.True(t, session.Connected)
+		_, rerr := session.Request(`{"action":"version"}`)
+		assert.Nil(t, rerr)
+		defer p.checkin(session, rerr)
+	}
+}
+
+func TestCheckoutDisconnected(t *testing.T) {
+	p := newPool("local:///nonexistent/node", 1, time.Second)
+	defer p.close()
+
+	_, err := p.checkout(context.Background())
+	require.NotNil(t, err)
+	assert.Equal(t, "Connection", err.Category)
+
+	// The session is back in the pool for the next attempt
+	assert.Equal(t, 1, len(p.idle))
+}
+
+func TestCheckoutReconnectContext(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	p := newPool(node.uri(), 1, time.Second)
+	defer p.close()
+
+	session := <-p.idle
+	_ = session.Close()
+	p.idle <- session
+
+	// The reconnection gives up with the request
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := p.checkout(ctx)
+	require.NotNil(t, err)
+	assert.Equal(t, "Context", err.Category)
+	assert.Equal(t, 1, len(p.idle))
+
+	session, err = p.checkout(context.Background())
+	require.Nil(t, err)
+	assert.True(t, session.Connected)
+	p.checkin(session, nil)
+}
@@ -0,0 +1,141 @@
+package usclient
+
+import (
+	"context"
+	"expvar"
+	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
+	"math/rand"
+	"time"
+)
+
+// Counters published on /debug/vars
+var (
+	// Requests sent to a node by action, retries included
+	ipcAttempts = expvar.NewMap("ipc_attempts")
+	// Retries by action
+	ipcRetries = expvar.NewMap("ipc_retries")
+	// Failed attempts by error category
+	ipcErrors = expvar.NewMap("ipc_errors")
+)
+
+// A RetryPolicy retries the requests of an action failing because of the
+// connection to the node
+type RetryPolicy struct {
+	// Attempts after the first one
+	Retries int `json:"retries"`
+	// Milliseconds before the first retry, doubled at each retry up to MaxBackoff
+	Backoff    int `json:"backoff"`
+	MaxBackoff int `json:"maxbackoff"`
+}
+
+var readRetry = RetryPolicy{Retries: 3, Backoff: 50, MaxBackoff: 1000}
+
+// DefaultRetry retries the read-only actions of the gateway. A send is
+// retried only when it has an id, which the node uses to avoid sending twice.
+var DefaultRetry = map[string]RetryPolicy{
+	"account_balance":         readRetry,
+	"accounts_balances":       readRetry,
+	"account_info":            readRetry,
+	"account_history":         readRetry,
+	"accounts_pending":        readRetry,
+	"block_info":              readRetry,
+	"blocks_info":             readRetry,
+	"block_count":             readRetry,
+	"version":                 readRetry,
+	"validate_account_number": readRetry,
+	"send":                    {Retries: 2, Backoff: 100, MaxBackoff: 1000},
+}
+
+// messageActions are the JSON actions matching the typed requests
+var messageActions = map[nanoapi.Message]string{
+	nanoapi.MessageAccountBalance: "account_balance",
+}
+
+// retryPolicy returns the policy of a request, nil if it must not be retried
+func (client *USClient) retryPolicy(action string, id string) *RetryPolicy {
+	if action == "send" && id == "" {
+		return nil
+	}
+
+	policies := client.conf.Retry
+	if policies == nil {
+		policies = DefaultRetry
+	}
+
+	policy, ok := policies[action]
+	if !ok || policy.Retries <= 0 {
+		return nil
+	}
+	return &policy
+}
+
+// backoff returns the delay before the given retry, picked at random in the
+// upper half of the exponential window
+func (policy *RetryPolicy) backoff(retry int) time.Duration {
+	min := time.Duration(policy.Backoff) * time.Millisecond
+	max := time.Duration(policy.MaxBackoff) * time.Millisecond
+	if min <= 0 {
+		min = time.Millisecond
+	}
+	if max < min {
+		max = min
+	}
+
+	d := min
+	for i := 0; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryable reports whether err may not happen again on another attempt
+func retryable(err *nanoipc.Error) bool {
+	switch err.Category {
+	case "Connection", "Network", "Pool":
+		return true
+	}
+	return false
+}
+
+// withRetry runs call for action, retrying as its policy allows. The backoff
+// never goes past the deadline of ctx.
+func (client *USClient) withRetry(ctx context.Context, action string, id string,
+	call func(session *nanoipc.Session) *nanoipc.Error) *nanoipc.Error {
+	policy := client.retryPolicy(action, id)
+
+	for retry := 0; ; retry++ {
+		ipcAttempts.Add(action, 1)
+
+		err := client.do(ctx, action, call)
+		if err == nil {
+			return nil
+		}
+		ipcErrors.Add(err.Category, 1)
+
+		if policy == nil || retry >= policy.Retries || !retryable(err) {
+			return err
+		}
+
+		delay := policy.backoff(retry)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			logger.Warnf("Not retrying %s, deadline too close: %s", action, err)
+			return err
+		}
+
+		logger.Warnf("Retrying %s in %s after attempt %d: %s", action, delay, retry+1, err)
+		ipcRetries.Add(action, 1)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
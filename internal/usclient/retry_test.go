@@ -0,0 +1,98 @@
+package usclient
+
+import (
+	"context"
+	"expvar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func counter(m *expvar.Map, key string) int64 {
+	if v, ok := m.Get(key).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestRetryPolicy(t *testing.T) {
+	client := USClient{conf: &ConfNode{}}
+
+	assert.NotNil(t, client.retryPolicy("block_info", ""))
+	assert.Nil(t, client.retryPolicy("account_create", ""))
+	assert.Nil(t, client.retryPolicy("send", ""))
+	assert.NotNil(t, client.retryPolicy("send", "tx1"))
+
+	client.conf.Retry = map[string]RetryPolicy{"block_info": {Retries: 0}, "version": {Retries: 1}}
+	assert.Nil(t, client.retryPolicy("block_info", ""))
+	assert.NotNil(t, client.retryPolicy("version", ""))
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{Backoff: 100, MaxBackoff: 300}
+
+	for retry := 0; retry < 5; retry++ {
+		d := policy.backoff(retry)
+		assert.True(t, d >= 50*time.Millisecond)
+		assert.True(t, d <= 300*time.Millisecond)
+	}
+
+	assert.True(t, policy.backoff(0) <= 100*time.Millisecond)
+}
+
+// brokenClient returns a client whose sessions have lost their connection
+func brokenClient(t *testing.T, node *fakeNode, retry map[string]RetryPolicy) *USClient {
+	client := &USClient{}
+	client.Init(&ConfNode{Connection: node.uri(), PoolSize: 1, ProbeInterval: 3600, Retry: retry}, nil)
+	node.waitConns(t, 2)
+	node.drop()
+	return client
+}
+
+func TestRetryAfterNetworkError(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := brokenClient(t, node, nil)
+	defer client.Close()
+
+	retries := counter(ipcRetries, "block_info")
+
+	reply, err := client.Get(context.Background(), []byte(`{"action":"block_info"}`))
+	require.Nil(t, err)
+	assert.Equal(t, `{"action":"block_info"}`, string(reply))
+	assert.Equal(t, retries+1, counter(ipcRetries, "block_info"))
+}
+
+func TestNoRetryOfSendWithoutID(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := brokenClient(t, node, nil)
+	defer client.Close()
+
+	attempts := counter(ipcAttempts, "send")
+
+	_, err := client.Get(context.Background(), []byte(`{"action":"send","wallet":"W1"}`))
+	assert.NotNil(t, err)
+	assert.Equal(t, attempts+1, counter(ipcAttempts, "send"))
+}
+
+func TestNoRetryPastDeadline(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	client := brokenClient(t, node, map[string]RetryPolicy{
+		"block_info": {Retries: 3, Backoff: 2000, MaxBackoff: 2000},
+	})
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Get(ctx, []byte(`{"action":"block_info"}`))
+	assert.NotNil(t, err)
+	assert.True(t, time.Since(start) < 200*time.Millisecond)
+}
@@ -1,27 +1,66 @@
 package usclient
 
 import (
+	"context"
 	"github.com/alvistar/nanopb/pkg/nanoipc"
+	"github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
 	log "github.com/sirupsen/logrus"
-	"sync/atomic"
+	"sync"
+	"time"
 )
 
 type IUSClient interface {
 	Init(conf *ConfNode, l *log.Logger)
-	Get(request []byte) ([]byte, error)
+	Get(ctx context.Context, request []byte) ([]byte, error)
+	GetMessage(ctx context.Context, message nanoipc.Message) (*nanoapi.Envelope, error)
+	Status() []NodeStatus
 }
 
 type USClient struct {
-	// Session pool
-	sessions      []*nanoipc.Session
-	nextSession   int32
-	conf          *ConfNode
-	needReconnect bool
+	conf *ConfNode
+	// Nodes with their session pools
+	endpoints    []*endpoint
+	primary      *endpoint
+	mutex        sync.RWMutex
+	next         uint64
+	maxBehind    uint64
+	probeTimeout time.Duration
+	// Serializes the probes, which share a session per node
+	probeMutex sync.Mutex
+	closing    chan struct{}
+	closeOnce  sync.Once
+	probeDone  chan struct{}
 }
 
+// Payload encodings accepted in ConfNode.Encoding
+const (
+	EncodingJSON        = "json"
+	EncodingFlatbuffers = "flatbuffers"
+)
+
 type ConfNode struct {
 	Connection string `json:"connection"`
 	PoolSize   int    `json:"poolsize"`
+	// Encoding used for typed requests, either EncodingJSON (default) or EncodingFlatbuffers.
+	// The flatbuffers API of the node only covers account_balance, the other
+	// requests always use JSON, including the block_info and blocks_info
+	// lookups of BlockInfo and BlocksInfo.
+	Encoding string `json:"encoding"`
+	// Seconds a request waits for an idle session. Default is 10.
+	WaitTimeout int `json:"waittimeout"`
+	// Seconds between health checks of the idle sessions. Default is 30.
+	HealthInterval int `json:"healthinterval"`
+	// Nodes sharing the requests, replacing Connection if not empty. Each
+	// node has a pool of PoolSize sessions.
+	Nodes []ConfEndpoint `json:"nodes"`
+	// Seconds between block_count and version probes of the nodes. Default is 10.
+	ProbeInterval int `json:"probeinterval"`
+	// Blocks a node may lag behind the others before it stops serving reads. Default is 1000.
+	MaxBehind uint64 `json:"maxbehind"`
+	// Retry policies by action. DefaultRetry if nil.
+	Retry map[string]RetryPolicy `json:"retry"`
+	// Circuit breaker of each node
+	Breaker ConfBreaker `json:"breaker"`
 }
 
 var logger *log.Entry
@@ -44,69 +83,132 @@ func (client *USClient) Init(conf *ConfNode, l *log.Logger) {
 		client.conf = conf
 	}
 
-	_ = client.tryConnectNode()
-}
+	size := client.conf.PoolSize
+	if size <= 0 {
+		size = 3
+	}
 
-// Try connecting to the Nano node
-func (client *USClient) tryConnectNode() *nanoipc.Error {
-	var err *nanoipc.Error
-	client.sessions = make([]*nanoipc.Session, 0)
-	for i := 0; err == nil && i < client.conf.PoolSize; i++ {
-		session := &nanoipc.Session{}
-		client.sessions = append(client.sessions, session)
-		err = session.Connect(client.conf.Connection)
+	waitTimeout := client.conf.WaitTimeout
+	if waitTimeout <= 0 {
+		waitTimeout = 10
 	}
-	if len(client.sessions) < client.conf.PoolSize {
-		logger.Info("Reconnection attempt required")
-		client.needReconnect = true
-	} else {
-		client.needReconnect = false
+
+	healthInterval := client.conf.HealthInterval
+	if healthInterval <= 0 {
+		healthInterval = 30
 	}
 
-	if err != nil {
-		logger.Error(err.Message)
+	probeInterval := client.conf.ProbeInterval
+	if probeInterval <= 0 {
+		probeInterval = 10
 	}
 
-	return err
-}
+	client.maxBehind = client.conf.MaxBehind
+	if client.maxBehind == 0 {
+		client.maxBehind = 1000
+	}
 
-func (client *USClient) reconnectNode() *nanoipc.Error {
-	for _, element := range client.sessions {
-		_ = element.Close()
+	client.probeTimeout = 5 * time.Second
+	client.closing = make(chan struct{})
+	client.probeDone = make(chan struct{})
+
+	for _, node := range client.conf.endpoints() {
+		e := newEndpoint(node, size, time.Duration(waitTimeout)*time.Second, client.conf.Breaker)
+		client.endpoints = append(client.endpoints, e)
+		go e.pool.healthCheck(time.Duration(healthInterval) * time.Second)
+
+		if node.Role == RolePrimary {
+			if client.primary != nil {
+				logger.Warnf("Ignoring primary role of %s, the primary is %s", node.Connection, client.primary.conf.Connection)
+				continue
+			}
+			client.primary = e
+		}
+	}
+
+	if client.primary == nil {
+		client.primary = client.endpoints[0]
+		logger.Warnf("No primary node, using %s", client.primary.conf.Connection)
 	}
-	client.sessions = nil
-	client.nextSession = 0
-	return client.tryConnectNode()
+
+	go client.probeLoop(time.Duration(probeInterval) * time.Second)
 }
 
-// getSession returns the next available session in a round-robin fashion
-func (client *USClient) getSession() *nanoipc.Session {
-	next := atomic.AddInt32(&client.nextSession, 1)
-	next = next % int32(client.conf.PoolSize)
-	return client.sessions[next]
+// Close stops the probes and health checks and closes the sessions
+func (client *USClient) Close() {
+	client.closeOnce.Do(func() {
+		close(client.closing)
+	})
+	<-client.probeDone
+
+	for _, e := range client.endpoints {
+		e.pool.close()
+	}
 }
 
-func (client *USClient) Get(request []byte) ([]byte, error) {
-	var err *nanoipc.Error
-	var reply []byte
+// do runs call on a session of the node serving action. Nodes failing with
+// connection errors stop serving reads until they pass a probe, and the
+// requests to a node whose breaker is open fail fast.
+func (client *USClient) do(ctx context.Context, action string, call func(session *nanoipc.Session) *nanoipc.Error) *nanoipc.Error {
+	e := client.route(ctx, action)
 
-	if client.needReconnect {
-		if err = client.tryConnectNode(); err == nil {
-			logger.Info("Reconnected successfully to node")
-		}
+	if !e.breaker.allow() {
+		return &nanoipc.Error{Code: 1, Message: "circuit breaker open for " + e.conf.Connection, Category: "Breaker"}
 	}
 
-	reply, err = client.getSession().Request(string(request))
+	// Waiting for a session of the gateway is not latency of the node
+	var latency time.Duration
+	session, err := e.pool.checkout(ctx)
+	if err == nil {
+		start := time.Now()
+		err = call(session)
+		e.pool.checkin(session, err)
 
-	if err!=nil && err.Category == "Network" {
-		if err = client.reconnectNode(); err != nil {
-			logger.Error("Unable to reconnect to node")
+		if !workActions[action] {
+			latency = time.Since(start)
 		}
 	}
 
+	e.breaker.record(err, latency)
+
+	if err != nil && (err.Category == "Connection" || err.Category == "Network") {
+		client.markDown(e, err)
+	}
+
+	return err
+}
+
+// Get sends a JSON request to the node. The call is aborted when ctx is done.
+func (client *USClient) Get(ctx context.Context, request []byte) ([]byte, error) {
+	var reply []byte
+
+	action, id := requestFields(request)
+
+	err := client.withRetry(ctx, action, id, func(session *nanoipc.Session) (err *nanoipc.Error) {
+		reply, err = session.RequestContext(ctx, string(request))
+		return
+	})
+
 	if err == nil {
 		return reply, nil
 	} else {
 		return reply, err
 	}
 }
+
+// GetMessage sends a typed request using the flatbuffers encoding. The typed
+// requests are read-only.
+func (client *USClient) GetMessage(ctx context.Context, message nanoipc.Message) (*nanoapi.Envelope, error) {
+	var reply *nanoapi.Envelope
+
+	err := client.withRetry(ctx, messageActions[message.Type], "", func(session *nanoipc.Session) (err *nanoipc.Error) {
+		reply, err = session.RequestMessageContext(ctx, message)
+		return
+	})
+
+	if err == nil {
+		return reply, nil
+	} else {
+		return nil, err
+	}
+}
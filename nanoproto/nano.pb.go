@@ -24,12 +24,108 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Which account of a confirmed block is matched against SubscribeRequest.accounts
+type AccountMatch int32
+
+const (
+	// block.link_as_account, the destination of send blocks
+	AccountMatch_RECIPIENT AccountMatch = 0
+	// message.account, the account owning the block
+	AccountMatch_SENDER AccountMatch = 1
+	AccountMatch_EITHER AccountMatch = 2
+)
+
+var AccountMatch_name = map[int32]string{
+	0: "RECIPIENT",
+	1: "SENDER",
+	2: "EITHER",
+}
+
+var AccountMatch_value = map[string]int32{
+	"RECIPIENT": 0,
+	"SENDER":    1,
+	"EITHER":    2,
+}
+
+func (x AccountMatch) String() string {
+	return proto.EnumName(AccountMatch_name, int32(x))
+}
+
+func (AccountMatch) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{0}
+}
+
+// What to do when a subscriber falls behind and its buffer is full
+type OverflowPolicy int32
+
+const (
+	// Close the stream with RESOURCE_EXHAUSTED. This is the default: clients
+	// that do not set overflow used to be waited for, they are now disconnected
+	// when they fall behind and must resubscribe, with resume_from if the
+	// gateway keeps a journal.
+	OverflowPolicy_DISCONNECT OverflowPolicy = 0
+	// Wait for the subscriber, delaying every other subscription, up to the
+	// block timeout of the gateway (5s by default). Past it the stream is closed
+	// as with DISCONNECT.
+	OverflowPolicy_BLOCK OverflowPolicy = 1
+	// Discard the oldest buffered entry
+	OverflowPolicy_DROP_OLDEST OverflowPolicy = 2
+)
+
+var OverflowPolicy_name = map[int32]string{
+	0: "DISCONNECT",
+	1: "BLOCK",
+	2: "DROP_OLDEST",
+}
+
+var OverflowPolicy_value = map[string]int32{
+	"DISCONNECT":  0,
+	"BLOCK":       1,
+	"DROP_OLDEST": 2,
+}
+
+func (x OverflowPolicy) String() string {
+	return proto.EnumName(OverflowPolicy_name, int32(x))
+}
+
+func (OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{1}
+}
+
+// State of the gateway connection to the node websocket
+type ConnectionStatus int32
+
+const (
+	ConnectionStatus_CONNECTED    ConnectionStatus = 0
+	ConnectionStatus_RECONNECTING ConnectionStatus = 1
+)
+
+var ConnectionStatus_name = map[int32]string{
+	0: "CONNECTED",
+	1: "RECONNECTING",
+}
+
+var ConnectionStatus_value = map[string]int32{
+	"CONNECTED":    0,
+	"RECONNECTING": 1,
+}
+
+func (x ConnectionStatus) String() string {
+	return proto.EnumName(ConnectionStatus_name, int32(x))
+}
+
+func (ConnectionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{2}
+}
+
 //Send
 type SendRequest struct {
-	Wallet               string   `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Destination          string   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	Amount               string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Wallet      string `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Idempotency key, a replayed id returns the block of the first send
+	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -88,6 +184,13 @@ func (m *SendRequest) GetAmount() string {
 	return ""
 }
 
+func (m *SendRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type SendReply struct {
 	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -127,1089 +230,3398 @@ func (m *SendReply) GetBlock() string {
 	return ""
 }
 
-//Validate Account Number
-type ValidateAccountNumberRequest struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+// Receive
+type ReceiveRequest struct {
+	Wallet  string `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Hash of the pending send block
+	Block string `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// Optional precomputed work
+	Work                 string   `protobuf:"bytes,4,opt,name=work,proto3" json:"work,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateAccountNumberRequest) Reset()         { *m = ValidateAccountNumberRequest{} }
-func (m *ValidateAccountNumberRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidateAccountNumberRequest) ProtoMessage()    {}
-func (*ValidateAccountNumberRequest) Descriptor() ([]byte, []int) {
+func (m *ReceiveRequest) Reset()         { *m = ReceiveRequest{} }
+func (m *ReceiveRequest) String() string { return proto.CompactTextString(m) }
+func (*ReceiveRequest) ProtoMessage()    {}
+func (*ReceiveRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_11bdccbcd58847bb, []int{2}
 }
 
-func (m *ValidateAccountNumberRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateAccountNumberRequest.Unmarshal(m, b)
+func (m *ReceiveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiveRequest.Unmarshal(m, b)
 }
-func (m *ValidateAccountNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateAccountNumberRequest.Marshal(b, m, deterministic)
+func (m *ReceiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiveRequest.Marshal(b, m, deterministic)
 }
-func (m *ValidateAccountNumberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateAccountNumberRequest.Merge(m, src)
+func (m *ReceiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiveRequest.Merge(m, src)
 }
-func (m *ValidateAccountNumberRequest) XXX_Size() int {
-	return xxx_messageInfo_ValidateAccountNumberRequest.Size(m)
+func (m *ReceiveRequest) XXX_Size() int {
+	return xxx_messageInfo_ReceiveRequest.Size(m)
 }
-func (m *ValidateAccountNumberRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateAccountNumberRequest.DiscardUnknown(m)
+func (m *ReceiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiveRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateAccountNumberRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReceiveRequest proto.InternalMessageInfo
 
-func (m *ValidateAccountNumberRequest) GetAccount() string {
+func (m *ReceiveRequest) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+func (m *ReceiveRequest) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-type ValidateAccountNumberReply struct {
-	Valid                string   `protobuf:"bytes,1,opt,name=valid,proto3" json:"valid,omitempty"`
+func (m *ReceiveRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *ReceiveRequest) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+type ReceiveReply struct {
+	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateAccountNumberReply) Reset()         { *m = ValidateAccountNumberReply{} }
-func (m *ValidateAccountNumberReply) String() string { return proto.CompactTextString(m) }
-func (*ValidateAccountNumberReply) ProtoMessage()    {}
-func (*ValidateAccountNumberReply) Descriptor() ([]byte, []int) {
+func (m *ReceiveReply) Reset()         { *m = ReceiveReply{} }
+func (m *ReceiveReply) String() string { return proto.CompactTextString(m) }
+func (*ReceiveReply) ProtoMessage()    {}
+func (*ReceiveReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_11bdccbcd58847bb, []int{3}
 }
 
-func (m *ValidateAccountNumberReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateAccountNumberReply.Unmarshal(m, b)
+func (m *ReceiveReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiveReply.Unmarshal(m, b)
 }
-func (m *ValidateAccountNumberReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateAccountNumberReply.Marshal(b, m, deterministic)
+func (m *ReceiveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiveReply.Marshal(b, m, deterministic)
 }
-func (m *ValidateAccountNumberReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateAccountNumberReply.Merge(m, src)
+func (m *ReceiveReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiveReply.Merge(m, src)
 }
-func (m *ValidateAccountNumberReply) XXX_Size() int {
-	return xxx_messageInfo_ValidateAccountNumberReply.Size(m)
+func (m *ReceiveReply) XXX_Size() int {
+	return xxx_messageInfo_ReceiveReply.Size(m)
 }
-func (m *ValidateAccountNumberReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateAccountNumberReply.DiscardUnknown(m)
+func (m *ReceiveReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiveReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateAccountNumberReply proto.InternalMessageInfo
+var xxx_messageInfo_ReceiveReply proto.InternalMessageInfo
 
-func (m *ValidateAccountNumberReply) GetValid() string {
+func (m *ReceiveReply) GetBlock() string {
 	if m != nil {
-		return m.Valid
+		return m.Block
 	}
 	return ""
 }
 
-//Account Create
-type AccountCreateRequest struct {
-	Wallet               string   `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+// Accounts Pending
+type AccountsPendingRequest struct {
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Maximum number of blocks per account, all if 0
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Minimum amount in raw
+	Threshold            string   `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	IncludeOnlyConfirmed bool     `protobuf:"varint,4,opt,name=include_only_confirmed,json=includeOnlyConfirmed,proto3" json:"include_only_confirmed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountCreateRequest) Reset()         { *m = AccountCreateRequest{} }
-func (m *AccountCreateRequest) String() string { return proto.CompactTextString(m) }
-func (*AccountCreateRequest) ProtoMessage()    {}
-func (*AccountCreateRequest) Descriptor() ([]byte, []int) {
+func (m *AccountsPendingRequest) Reset()         { *m = AccountsPendingRequest{} }
+func (m *AccountsPendingRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsPendingRequest) ProtoMessage()    {}
+func (*AccountsPendingRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_11bdccbcd58847bb, []int{4}
 }
 
-func (m *AccountCreateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountCreateRequest.Unmarshal(m, b)
+func (m *AccountsPendingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsPendingRequest.Unmarshal(m, b)
 }
-func (m *AccountCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountCreateRequest.Marshal(b, m, deterministic)
+func (m *AccountsPendingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsPendingRequest.Marshal(b, m, deterministic)
 }
-func (m *AccountCreateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountCreateRequest.Merge(m, src)
+func (m *AccountsPendingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsPendingRequest.Merge(m, src)
 }
-func (m *AccountCreateRequest) XXX_Size() int {
-	return xxx_messageInfo_AccountCreateRequest.Size(m)
+func (m *AccountsPendingRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsPendingRequest.Size(m)
 }
-func (m *AccountCreateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountCreateRequest.DiscardUnknown(m)
+func (m *AccountsPendingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsPendingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountCreateRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountsPendingRequest proto.InternalMessageInfo
 
-func (m *AccountCreateRequest) GetWallet() string {
+func (m *AccountsPendingRequest) GetAccounts() []string {
 	if m != nil {
-		return m.Wallet
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *AccountsPendingRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AccountsPendingRequest) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
 	return ""
 }
 
-type AccountCreateReply struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+func (m *AccountsPendingRequest) GetIncludeOnlyConfirmed() bool {
+	if m != nil {
+		return m.IncludeOnlyConfirmed
+	}
+	return false
+}
+
+type PendingBlock struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountCreateReply) Reset()         { *m = AccountCreateReply{} }
-func (m *AccountCreateReply) String() string { return proto.CompactTextString(m) }
-func (*AccountCreateReply) ProtoMessage()    {}
-func (*AccountCreateReply) Descriptor() ([]byte, []int) {
+func (m *PendingBlock) Reset()         { *m = PendingBlock{} }
+func (m *PendingBlock) String() string { return proto.CompactTextString(m) }
+func (*PendingBlock) ProtoMessage()    {}
+func (*PendingBlock) Descriptor() ([]byte, []int) {
 	return fileDescriptor_11bdccbcd58847bb, []int{5}
 }
 
-func (m *AccountCreateReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountCreateReply.Unmarshal(m, b)
+func (m *PendingBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingBlock.Unmarshal(m, b)
 }
-func (m *AccountCreateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountCreateReply.Marshal(b, m, deterministic)
+func (m *PendingBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingBlock.Marshal(b, m, deterministic)
 }
-func (m *AccountCreateReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountCreateReply.Merge(m, src)
+func (m *PendingBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBlock.Merge(m, src)
 }
-func (m *AccountCreateReply) XXX_Size() int {
-	return xxx_messageInfo_AccountCreateReply.Size(m)
+func (m *PendingBlock) XXX_Size() int {
+	return xxx_messageInfo_PendingBlock.Size(m)
 }
-func (m *AccountCreateReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountCreateReply.DiscardUnknown(m)
+func (m *PendingBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountCreateReply proto.InternalMessageInfo
+var xxx_messageInfo_PendingBlock proto.InternalMessageInfo
 
-func (m *AccountCreateReply) GetAccount() string {
+func (m *PendingBlock) GetHash() string {
 	if m != nil {
-		return m.Account
+		return m.Hash
 	}
 	return ""
 }
 
-// Account Balance
-type AccountBalanceRequest struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingBlock) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *AccountBalanceRequest) Reset()         { *m = AccountBalanceRequest{} }
-func (m *AccountBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*AccountBalanceRequest) ProtoMessage()    {}
-func (*AccountBalanceRequest) Descriptor() ([]byte, []int) {
+func (m *PendingBlock) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type PendingBlocks struct {
+	// Largest amounts first
+	Blocks               []*PendingBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PendingBlocks) Reset()         { *m = PendingBlocks{} }
+func (m *PendingBlocks) String() string { return proto.CompactTextString(m) }
+func (*PendingBlocks) ProtoMessage()    {}
+func (*PendingBlocks) Descriptor() ([]byte, []int) {
 	return fileDescriptor_11bdccbcd58847bb, []int{6}
 }
 
-func (m *AccountBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountBalanceRequest.Unmarshal(m, b)
+func (m *PendingBlocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingBlocks.Unmarshal(m, b)
 }
-func (m *AccountBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountBalanceRequest.Marshal(b, m, deterministic)
+func (m *PendingBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingBlocks.Marshal(b, m, deterministic)
 }
-func (m *AccountBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountBalanceRequest.Merge(m, src)
+func (m *PendingBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBlocks.Merge(m, src)
 }
-func (m *AccountBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_AccountBalanceRequest.Size(m)
+func (m *PendingBlocks) XXX_Size() int {
+	return xxx_messageInfo_PendingBlocks.Size(m)
 }
-func (m *AccountBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountBalanceRequest.DiscardUnknown(m)
+func (m *PendingBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBlocks.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_PendingBlocks proto.InternalMessageInfo
 
-func (m *AccountBalanceRequest) GetAccount() string {
+func (m *PendingBlocks) GetBlocks() []*PendingBlock {
 	if m != nil {
-		return m.Account
+		return m.Blocks
 	}
-	return ""
+	return nil
 }
 
-type AccountBalanceReply struct {
-	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	Pending              string   `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+type AccountsPendingReply struct {
+	Blocks               map[string]*PendingBlocks `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AccountsPendingReply) Reset()         { *m = AccountsPendingReply{} }
+func (m *AccountsPendingReply) String() string { return proto.CompactTextString(m) }
+func (*AccountsPendingReply) ProtoMessage()    {}
+func (*AccountsPendingReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{7}
+}
+
+func (m *AccountsPendingReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsPendingReply.Unmarshal(m, b)
+}
+func (m *AccountsPendingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsPendingReply.Marshal(b, m, deterministic)
+}
+func (m *AccountsPendingReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsPendingReply.Merge(m, src)
+}
+func (m *AccountsPendingReply) XXX_Size() int {
+	return xxx_messageInfo_AccountsPendingReply.Size(m)
+}
+func (m *AccountsPendingReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsPendingReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsPendingReply proto.InternalMessageInfo
+
+func (m *AccountsPendingReply) GetBlocks() map[string]*PendingBlocks {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+// Raw Request
+type RawActionRequest struct {
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// JSON object with the parameters of the action
+	Json                 string   `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountBalanceReply) Reset()         { *m = AccountBalanceReply{} }
-func (m *AccountBalanceReply) String() string { return proto.CompactTextString(m) }
-func (*AccountBalanceReply) ProtoMessage()    {}
-func (*AccountBalanceReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{7}
+func (m *RawActionRequest) Reset()         { *m = RawActionRequest{} }
+func (m *RawActionRequest) String() string { return proto.CompactTextString(m) }
+func (*RawActionRequest) ProtoMessage()    {}
+func (*RawActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{8}
 }
 
-func (m *AccountBalanceReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountBalanceReply.Unmarshal(m, b)
+func (m *RawActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RawActionRequest.Unmarshal(m, b)
 }
-func (m *AccountBalanceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountBalanceReply.Marshal(b, m, deterministic)
+func (m *RawActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RawActionRequest.Marshal(b, m, deterministic)
 }
-func (m *AccountBalanceReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountBalanceReply.Merge(m, src)
+func (m *RawActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawActionRequest.Merge(m, src)
 }
-func (m *AccountBalanceReply) XXX_Size() int {
-	return xxx_messageInfo_AccountBalanceReply.Size(m)
+func (m *RawActionRequest) XXX_Size() int {
+	return xxx_messageInfo_RawActionRequest.Size(m)
 }
-func (m *AccountBalanceReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountBalanceReply.DiscardUnknown(m)
+func (m *RawActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawActionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountBalanceReply proto.InternalMessageInfo
+var xxx_messageInfo_RawActionRequest proto.InternalMessageInfo
 
-func (m *AccountBalanceReply) GetBalance() string {
+func (m *RawActionRequest) GetAction() string {
 	if m != nil {
-		return m.Balance
+		return m.Action
 	}
 	return ""
 }
 
-func (m *AccountBalanceReply) GetPending() string {
+func (m *RawActionRequest) GetJson() string {
 	if m != nil {
-		return m.Pending
+		return m.Json
 	}
 	return ""
 }
 
-type AccountsBalancesRequest struct {
-	Accounts             []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+type RawActionReply struct {
+	// JSON reply of the node
+	Json                 string   `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountsBalancesRequest) Reset()         { *m = AccountsBalancesRequest{} }
-func (m *AccountsBalancesRequest) String() string { return proto.CompactTextString(m) }
-func (*AccountsBalancesRequest) ProtoMessage()    {}
-func (*AccountsBalancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{8}
+func (m *RawActionReply) Reset()         { *m = RawActionReply{} }
+func (m *RawActionReply) String() string { return proto.CompactTextString(m) }
+func (*RawActionReply) ProtoMessage()    {}
+func (*RawActionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{9}
 }
 
-func (m *AccountsBalancesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountsBalancesRequest.Unmarshal(m, b)
+func (m *RawActionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RawActionReply.Unmarshal(m, b)
 }
-func (m *AccountsBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountsBalancesRequest.Marshal(b, m, deterministic)
+func (m *RawActionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RawActionReply.Marshal(b, m, deterministic)
 }
-func (m *AccountsBalancesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountsBalancesRequest.Merge(m, src)
+func (m *RawActionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawActionReply.Merge(m, src)
 }
-func (m *AccountsBalancesRequest) XXX_Size() int {
-	return xxx_messageInfo_AccountsBalancesRequest.Size(m)
+func (m *RawActionReply) XXX_Size() int {
+	return xxx_messageInfo_RawActionReply.Size(m)
 }
-func (m *AccountsBalancesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountsBalancesRequest.DiscardUnknown(m)
+func (m *RawActionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawActionReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountsBalancesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RawActionReply proto.InternalMessageInfo
+
+func (m *RawActionReply) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+// Status
+type StatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
+func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()    {}
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{10}
+}
+
+func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusRequest.Unmarshal(m, b)
+}
+func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+}
+func (m *StatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRequest.Merge(m, src)
+}
+func (m *StatusRequest) XXX_Size() int {
+	return xxx_messageInfo_StatusRequest.Size(m)
+}
+func (m *StatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+
+type NodeStatus struct {
+	Connection string `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	// primary or secondary
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// Passed the last probe
+	Healthy bool `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Not lagging behind the other nodes
+	Synced     bool   `protobuf:"varint,4,opt,name=synced,proto3" json:"synced,omitempty"`
+	BlockCount uint64 `protobuf:"varint,5,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	Version    string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Circuit breaker state: closed, open or half-open
+	Breaker string `protobuf:"bytes,7,opt,name=breaker,proto3" json:"breaker,omitempty"`
+	// Consecutive failures counted by the breaker
+	Failures             uint32   `protobuf:"varint,8,opt,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeStatus) Reset()         { *m = NodeStatus{} }
+func (m *NodeStatus) String() string { return proto.CompactTextString(m) }
+func (*NodeStatus) ProtoMessage()    {}
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{11}
+}
+
+func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStatus.Unmarshal(m, b)
+}
+func (m *NodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStatus.Marshal(b, m, deterministic)
+}
+func (m *NodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStatus.Merge(m, src)
+}
+func (m *NodeStatus) XXX_Size() int {
+	return xxx_messageInfo_NodeStatus.Size(m)
+}
+func (m *NodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
+
+func (m *NodeStatus) GetConnection() string {
+	if m != nil {
+		return m.Connection
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *NodeStatus) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+func (m *NodeStatus) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *NodeStatus) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetBreaker() string {
+	if m != nil {
+		return m.Breaker
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+type StatusReply struct {
+	Nodes                []*NodeStatus `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	WebsocketConnected   bool          `protobuf:"varint,2,opt,name=websocket_connected,json=websocketConnected,proto3" json:"websocket_connected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StatusReply) Reset()         { *m = StatusReply{} }
+func (m *StatusReply) String() string { return proto.CompactTextString(m) }
+func (*StatusReply) ProtoMessage()    {}
+func (*StatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{12}
+}
+
+func (m *StatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusReply.Unmarshal(m, b)
+}
+func (m *StatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusReply.Marshal(b, m, deterministic)
+}
+func (m *StatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusReply.Merge(m, src)
+}
+func (m *StatusReply) XXX_Size() int {
+	return xxx_messageInfo_StatusReply.Size(m)
+}
+func (m *StatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusReply proto.InternalMessageInfo
+
+func (m *StatusReply) GetNodes() []*NodeStatus {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *StatusReply) GetWebsocketConnected() bool {
+	if m != nil {
+		return m.WebsocketConnected
+	}
+	return false
+}
+
+//Validate Account Number
+type ValidateAccountNumberRequest struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAccountNumberRequest) Reset()         { *m = ValidateAccountNumberRequest{} }
+func (m *ValidateAccountNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateAccountNumberRequest) ProtoMessage()    {}
+func (*ValidateAccountNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{13}
+}
+
+func (m *ValidateAccountNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAccountNumberRequest.Unmarshal(m, b)
+}
+func (m *ValidateAccountNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAccountNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateAccountNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAccountNumberRequest.Merge(m, src)
+}
+func (m *ValidateAccountNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateAccountNumberRequest.Size(m)
+}
+func (m *ValidateAccountNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAccountNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAccountNumberRequest proto.InternalMessageInfo
+
+func (m *ValidateAccountNumberRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type ValidateAccountNumberReply struct {
+	Valid                string   `protobuf:"bytes,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAccountNumberReply) Reset()         { *m = ValidateAccountNumberReply{} }
+func (m *ValidateAccountNumberReply) String() string { return proto.CompactTextString(m) }
+func (*ValidateAccountNumberReply) ProtoMessage()    {}
+func (*ValidateAccountNumberReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{14}
+}
+
+func (m *ValidateAccountNumberReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAccountNumberReply.Unmarshal(m, b)
+}
+func (m *ValidateAccountNumberReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAccountNumberReply.Marshal(b, m, deterministic)
+}
+func (m *ValidateAccountNumberReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAccountNumberReply.Merge(m, src)
+}
+func (m *ValidateAccountNumberReply) XXX_Size() int {
+	return xxx_messageInfo_ValidateAccountNumberReply.Size(m)
+}
+func (m *ValidateAccountNumberReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAccountNumberReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAccountNumberReply proto.InternalMessageInfo
+
+func (m *ValidateAccountNumberReply) GetValid() string {
+	if m != nil {
+		return m.Valid
+	}
+	return ""
+}
+
+//Account Create
+type AccountCreateRequest struct {
+	Wallet               string   `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountCreateRequest) Reset()         { *m = AccountCreateRequest{} }
+func (m *AccountCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountCreateRequest) ProtoMessage()    {}
+func (*AccountCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{15}
+}
+
+func (m *AccountCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountCreateRequest.Unmarshal(m, b)
+}
+func (m *AccountCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCreateRequest.Merge(m, src)
+}
+func (m *AccountCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountCreateRequest.Size(m)
+}
+func (m *AccountCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountCreateRequest proto.InternalMessageInfo
+
+func (m *AccountCreateRequest) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+type AccountCreateReply struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountCreateReply) Reset()         { *m = AccountCreateReply{} }
+func (m *AccountCreateReply) String() string { return proto.CompactTextString(m) }
+func (*AccountCreateReply) ProtoMessage()    {}
+func (*AccountCreateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{16}
+}
+
+func (m *AccountCreateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountCreateReply.Unmarshal(m, b)
+}
+func (m *AccountCreateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountCreateReply.Marshal(b, m, deterministic)
+}
+func (m *AccountCreateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCreateReply.Merge(m, src)
+}
+func (m *AccountCreateReply) XXX_Size() int {
+	return xxx_messageInfo_AccountCreateReply.Size(m)
+}
+func (m *AccountCreateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCreateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountCreateReply proto.InternalMessageInfo
+
+func (m *AccountCreateReply) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// Account Balance
+type AccountBalanceRequest struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountBalanceRequest) Reset()         { *m = AccountBalanceRequest{} }
+func (m *AccountBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountBalanceRequest) ProtoMessage()    {}
+func (*AccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{17}
+}
+
+func (m *AccountBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountBalanceRequest.Unmarshal(m, b)
+}
+func (m *AccountBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalanceRequest.Merge(m, src)
+}
+func (m *AccountBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountBalanceRequest.Size(m)
+}
+func (m *AccountBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalanceRequest proto.InternalMessageInfo
+
+func (m *AccountBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type AccountBalanceReply struct {
+	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Pending              string   `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountBalanceReply) Reset()         { *m = AccountBalanceReply{} }
+func (m *AccountBalanceReply) String() string { return proto.CompactTextString(m) }
+func (*AccountBalanceReply) ProtoMessage()    {}
+func (*AccountBalanceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{18}
+}
+
+func (m *AccountBalanceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountBalanceReply.Unmarshal(m, b)
+}
+func (m *AccountBalanceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountBalanceReply.Marshal(b, m, deterministic)
+}
+func (m *AccountBalanceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalanceReply.Merge(m, src)
+}
+func (m *AccountBalanceReply) XXX_Size() int {
+	return xxx_messageInfo_AccountBalanceReply.Size(m)
+}
+func (m *AccountBalanceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalanceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalanceReply proto.InternalMessageInfo
+
+func (m *AccountBalanceReply) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountBalanceReply) GetPending() string {
+	if m != nil {
+		return m.Pending
+	}
+	return ""
+}
+
+// Account Info
+type AccountInfoRequest struct {
+	Account        string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Representative bool   `protobuf:"varint,2,opt,name=representative,proto3" json:"representative,omitempty"`
+	Weight         bool   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Pending        bool   `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Also return the values as of the confirmation height
+	IncludeConfirmed     bool     `protobuf:"varint,5,opt,name=include_confirmed,json=includeConfirmed,proto3" json:"include_confirmed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountInfoRequest) Reset()         { *m = AccountInfoRequest{} }
+func (m *AccountInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountInfoRequest) ProtoMessage()    {}
+func (*AccountInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{19}
+}
+
+func (m *AccountInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountInfoRequest.Unmarshal(m, b)
+}
+func (m *AccountInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountInfoRequest.Merge(m, src)
+}
+func (m *AccountInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountInfoRequest.Size(m)
+}
+func (m *AccountInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountInfoRequest proto.InternalMessageInfo
+
+func (m *AccountInfoRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountInfoRequest) GetRepresentative() bool {
+	if m != nil {
+		return m.Representative
+	}
+	return false
+}
+
+func (m *AccountInfoRequest) GetWeight() bool {
+	if m != nil {
+		return m.Weight
+	}
+	return false
+}
+
+func (m *AccountInfoRequest) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *AccountInfoRequest) GetIncludeConfirmed() bool {
+	if m != nil {
+		return m.IncludeConfirmed
+	}
+	return false
+}
+
+type AccountInfoReply struct {
+	Frontier                   string   `protobuf:"bytes,1,opt,name=frontier,proto3" json:"frontier,omitempty"`
+	OpenBlock                  string   `protobuf:"bytes,2,opt,name=open_block,json=openBlock,proto3" json:"open_block,omitempty"`
+	RepresentativeBlock        string   `protobuf:"bytes,3,opt,name=representative_block,json=representativeBlock,proto3" json:"representative_block,omitempty"`
+	Balance                    string   `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	ModifiedTimestamp          string   `protobuf:"bytes,5,opt,name=modified_timestamp,json=modifiedTimestamp,proto3" json:"modified_timestamp,omitempty"`
+	BlockCount                 string   `protobuf:"bytes,6,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	AccountVersion             string   `protobuf:"bytes,7,opt,name=account_version,json=accountVersion,proto3" json:"account_version,omitempty"`
+	ConfirmationHeight         string   `protobuf:"bytes,8,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
+	ConfirmationHeightFrontier string   `protobuf:"bytes,9,opt,name=confirmation_height_frontier,json=confirmationHeightFrontier,proto3" json:"confirmation_height_frontier,omitempty"`
+	Representative             string   `protobuf:"bytes,10,opt,name=representative,proto3" json:"representative,omitempty"`
+	Weight                     string   `protobuf:"bytes,11,opt,name=weight,proto3" json:"weight,omitempty"`
+	Pending                    string   `protobuf:"bytes,12,opt,name=pending,proto3" json:"pending,omitempty"`
+	Receivable                 string   `protobuf:"bytes,13,opt,name=receivable,proto3" json:"receivable,omitempty"`
+	ConfirmedBalance           string   `protobuf:"bytes,14,opt,name=confirmed_balance,json=confirmedBalance,proto3" json:"confirmed_balance,omitempty"`
+	ConfirmedHeight            string   `protobuf:"bytes,15,opt,name=confirmed_height,json=confirmedHeight,proto3" json:"confirmed_height,omitempty"`
+	ConfirmedFrontier          string   `protobuf:"bytes,16,opt,name=confirmed_frontier,json=confirmedFrontier,proto3" json:"confirmed_frontier,omitempty"`
+	ConfirmedRepresentative    string   `protobuf:"bytes,17,opt,name=confirmed_representative,json=confirmedRepresentative,proto3" json:"confirmed_representative,omitempty"`
+	ConfirmedPending           string   `protobuf:"bytes,18,opt,name=confirmed_pending,json=confirmedPending,proto3" json:"confirmed_pending,omitempty"`
+	ConfirmedReceivable        string   `protobuf:"bytes,19,opt,name=confirmed_receivable,json=confirmedReceivable,proto3" json:"confirmed_receivable,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *AccountInfoReply) Reset()         { *m = AccountInfoReply{} }
+func (m *AccountInfoReply) String() string { return proto.CompactTextString(m) }
+func (*AccountInfoReply) ProtoMessage()    {}
+func (*AccountInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{20}
+}
+
+func (m *AccountInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountInfoReply.Unmarshal(m, b)
+}
+func (m *AccountInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountInfoReply.Marshal(b, m, deterministic)
+}
+func (m *AccountInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountInfoReply.Merge(m, src)
+}
+func (m *AccountInfoReply) XXX_Size() int {
+	return xxx_messageInfo_AccountInfoReply.Size(m)
+}
+func (m *AccountInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountInfoReply proto.InternalMessageInfo
+
+func (m *AccountInfoReply) GetFrontier() string {
+	if m != nil {
+		return m.Frontier
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetOpenBlock() string {
+	if m != nil {
+		return m.OpenBlock
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetRepresentativeBlock() string {
+	if m != nil {
+		return m.RepresentativeBlock
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetModifiedTimestamp() string {
+	if m != nil {
+		return m.ModifiedTimestamp
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetBlockCount() string {
+	if m != nil {
+		return m.BlockCount
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetAccountVersion() string {
+	if m != nil {
+		return m.AccountVersion
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmationHeight() string {
+	if m != nil {
+		return m.ConfirmationHeight
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmationHeightFrontier() string {
+	if m != nil {
+		return m.ConfirmationHeightFrontier
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetPending() string {
+	if m != nil {
+		return m.Pending
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetReceivable() string {
+	if m != nil {
+		return m.Receivable
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmedBalance() string {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmedHeight() string {
+	if m != nil {
+		return m.ConfirmedHeight
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmedFrontier() string {
+	if m != nil {
+		return m.ConfirmedFrontier
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmedRepresentative() string {
+	if m != nil {
+		return m.ConfirmedRepresentative
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmedPending() string {
+	if m != nil {
+		return m.ConfirmedPending
+	}
+	return ""
+}
+
+func (m *AccountInfoReply) GetConfirmedReceivable() string {
+	if m != nil {
+		return m.ConfirmedReceivable
+	}
+	return ""
+}
+
+// Account History
+type AccountHistoryRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Maximum number of entries to stream, the whole chain if 0
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Entries fetched from the node at a time. Default is 100.
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Block to start from instead of the frontier, or of the open block if reverse
+	Head string `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+	// Blocks to skip from head
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// From the open block to the frontier
+	Reverse bool `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// Include the fields of the block contents
+	Raw bool `protobuf:"varint,7,opt,name=raw,proto3" json:"raw,omitempty"`
+	// Only blocks exchanged with these accounts
+	AccountFilter []string `protobuf:"bytes,8,rep,name=account_filter,json=accountFilter,proto3" json:"account_filter,omitempty"`
+	// Continuation of an entry received on a previous stream, overrides head and
+	// offset. The other fields must be unchanged.
+	Continuation         string   `protobuf:"bytes,9,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountHistoryRequest) Reset()         { *m = AccountHistoryRequest{} }
+func (m *AccountHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryRequest) ProtoMessage()    {}
+func (*AccountHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{21}
+}
+
+func (m *AccountHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountHistoryRequest.Unmarshal(m, b)
+}
+func (m *AccountHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryRequest.Merge(m, src)
+}
+func (m *AccountHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountHistoryRequest.Size(m)
+}
+func (m *AccountHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryRequest proto.InternalMessageInfo
+
+func (m *AccountHistoryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountHistoryRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AccountHistoryRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *AccountHistoryRequest) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+func (m *AccountHistoryRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AccountHistoryRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *AccountHistoryRequest) GetRaw() bool {
+	if m != nil {
+		return m.Raw
+	}
+	return false
+}
+
+func (m *AccountHistoryRequest) GetAccountFilter() []string {
+	if m != nil {
+		return m.AccountFilter
+	}
+	return nil
+}
+
+func (m *AccountHistoryRequest) GetContinuation() string {
+	if m != nil {
+		return m.Continuation
+	}
+	return ""
+}
+
+type AccountHistoryEntry struct {
+	Type           string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Account        string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocalTimestamp string `protobuf:"bytes,4,opt,name=local_timestamp,json=localTimestamp,proto3" json:"local_timestamp,omitempty"`
+	Height         string `protobuf:"bytes,5,opt,name=height,proto3" json:"height,omitempty"`
+	Hash           string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	Confirmed      string `protobuf:"bytes,7,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Set with raw only
+	Representative string `protobuf:"bytes,8,opt,name=representative,proto3" json:"representative,omitempty"`
+	Link           string `protobuf:"bytes,9,opt,name=link,proto3" json:"link,omitempty"`
+	Balance        string `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
+	Previous       string `protobuf:"bytes,11,opt,name=previous,proto3" json:"previous,omitempty"`
+	Subtype        string `protobuf:"bytes,12,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	Signature      string `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	Work           string `protobuf:"bytes,14,opt,name=work,proto3" json:"work,omitempty"`
+	// Resumes the history after this entry, empty after the last one
+	Continuation         string   `protobuf:"bytes,15,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountHistoryEntry) Reset()         { *m = AccountHistoryEntry{} }
+func (m *AccountHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*AccountHistoryEntry) ProtoMessage()    {}
+func (*AccountHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{22}
+}
+
+func (m *AccountHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountHistoryEntry.Unmarshal(m, b)
+}
+func (m *AccountHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountHistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *AccountHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryEntry.Merge(m, src)
+}
+func (m *AccountHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_AccountHistoryEntry.Size(m)
+}
+func (m *AccountHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryEntry proto.InternalMessageInfo
+
+func (m *AccountHistoryEntry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetLocalTimestamp() string {
+	if m != nil {
+		return m.LocalTimestamp
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetConfirmed() string {
+	if m != nil {
+		return m.Confirmed
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+func (m *AccountHistoryEntry) GetContinuation() string {
+	if m != nil {
+		return m.Continuation
+	}
+	return ""
+}
+
+type AccountsBalancesRequest struct {
+	Accounts             []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountsBalancesRequest) Reset()         { *m = AccountsBalancesRequest{} }
+func (m *AccountsBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsBalancesRequest) ProtoMessage()    {}
+func (*AccountsBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{23}
+}
+
+func (m *AccountsBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsBalancesRequest.Unmarshal(m, b)
+}
+func (m *AccountsBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsBalancesRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountsBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsBalancesRequest.Merge(m, src)
+}
+func (m *AccountsBalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsBalancesRequest.Size(m)
+}
+func (m *AccountsBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsBalancesRequest proto.InternalMessageInfo
 
 func (m *AccountsBalancesRequest) GetAccounts() []string {
 	if m != nil {
-		return m.Accounts
+		return m.Accounts
+	}
+	return nil
+}
+
+type Balance struct {
+	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Pending              string   `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Balance) Reset()         { *m = Balance{} }
+func (m *Balance) String() string { return proto.CompactTextString(m) }
+func (*Balance) ProtoMessage()    {}
+func (*Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{24}
+}
+
+func (m *Balance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Balance.Unmarshal(m, b)
+}
+func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+}
+func (m *Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Balance.Merge(m, src)
+}
+func (m *Balance) XXX_Size() int {
+	return xxx_messageInfo_Balance.Size(m)
+}
+func (m *Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Balance proto.InternalMessageInfo
+
+func (m *Balance) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *Balance) GetPending() string {
+	if m != nil {
+		return m.Pending
+	}
+	return ""
+}
+
+type AccountsBalancesReply struct {
+	Balances             map[string]*Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AccountsBalancesReply) Reset()         { *m = AccountsBalancesReply{} }
+func (m *AccountsBalancesReply) String() string { return proto.CompactTextString(m) }
+func (*AccountsBalancesReply) ProtoMessage()    {}
+func (*AccountsBalancesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{25}
+}
+
+func (m *AccountsBalancesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsBalancesReply.Unmarshal(m, b)
+}
+func (m *AccountsBalancesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsBalancesReply.Marshal(b, m, deterministic)
+}
+func (m *AccountsBalancesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsBalancesReply.Merge(m, src)
+}
+func (m *AccountsBalancesReply) XXX_Size() int {
+	return xxx_messageInfo_AccountsBalancesReply.Size(m)
+}
+func (m *AccountsBalancesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsBalancesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsBalancesReply proto.InternalMessageInfo
+
+func (m *AccountsBalancesReply) GetBalances() map[string]*Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// BlockInfo Request
+type BlockInfoRequest struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockInfoRequest) Reset()         { *m = BlockInfoRequest{} }
+func (m *BlockInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockInfoRequest) ProtoMessage()    {}
+func (*BlockInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{26}
+}
+
+func (m *BlockInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfoRequest.Unmarshal(m, b)
+}
+func (m *BlockInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfoRequest.Merge(m, src)
+}
+func (m *BlockInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockInfoRequest.Size(m)
+}
+func (m *BlockInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfoRequest proto.InternalMessageInfo
+
+func (m *BlockInfoRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type BlockInfoReply struct {
+	BlockAccount         string         `protobuf:"bytes,1,opt,name=block_account,json=blockAccount,proto3" json:"block_account,omitempty"`
+	Amount               string         `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance              string         `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Height               string         `protobuf:"bytes,4,opt,name=height,proto3" json:"height,omitempty"`
+	LocalTimestamp       string         `protobuf:"bytes,5,opt,name=local_timestamp,json=localTimestamp,proto3" json:"local_timestamp,omitempty"`
+	Confirmed            string         `protobuf:"bytes,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Contents             *BlockContents `protobuf:"bytes,7,opt,name=contents,proto3" json:"contents,omitempty"`
+	Subtype              string         `protobuf:"bytes,8,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BlockInfoReply) Reset()         { *m = BlockInfoReply{} }
+func (m *BlockInfoReply) String() string { return proto.CompactTextString(m) }
+func (*BlockInfoReply) ProtoMessage()    {}
+func (*BlockInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{27}
+}
+
+func (m *BlockInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfoReply.Unmarshal(m, b)
+}
+func (m *BlockInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfoReply.Marshal(b, m, deterministic)
+}
+func (m *BlockInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfoReply.Merge(m, src)
+}
+func (m *BlockInfoReply) XXX_Size() int {
+	return xxx_messageInfo_BlockInfoReply.Size(m)
+}
+func (m *BlockInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfoReply proto.InternalMessageInfo
+
+func (m *BlockInfoReply) GetBlockAccount() string {
+	if m != nil {
+		return m.BlockAccount
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetLocalTimestamp() string {
+	if m != nil {
+		return m.LocalTimestamp
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetConfirmed() string {
+	if m != nil {
+		return m.Confirmed
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetContents() *BlockContents {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *BlockInfoReply) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+type BlockContents struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Previous             string   `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	Representative       string   `protobuf:"bytes,4,opt,name=representative,proto3" json:"representative,omitempty"`
+	Balance              string   `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Link                 string   `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	LinkAsAccount        string   `protobuf:"bytes,7,opt,name=link_as_account,json=linkAsAccount,proto3" json:"link_as_account,omitempty"`
+	Signature            string   `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	Work                 string   `protobuf:"bytes,9,opt,name=work,proto3" json:"work,omitempty"`
+	Subtype              string   `protobuf:"bytes,10,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockContents) Reset()         { *m = BlockContents{} }
+func (m *BlockContents) String() string { return proto.CompactTextString(m) }
+func (*BlockContents) ProtoMessage()    {}
+func (*BlockContents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{28}
+}
+
+func (m *BlockContents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockContents.Unmarshal(m, b)
+}
+func (m *BlockContents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockContents.Marshal(b, m, deterministic)
+}
+func (m *BlockContents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContents.Merge(m, src)
+}
+func (m *BlockContents) XXX_Size() int {
+	return xxx_messageInfo_BlockContents.Size(m)
+}
+func (m *BlockContents) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContents proto.InternalMessageInfo
+
+func (m *BlockContents) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BlockContents) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *BlockContents) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *BlockContents) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
+}
+
+func (m *BlockContents) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *BlockContents) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *BlockContents) GetLinkAsAccount() string {
+	if m != nil {
+		return m.LinkAsAccount
+	}
+	return ""
+}
+
+func (m *BlockContents) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *BlockContents) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+func (m *BlockContents) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+type BlocksInfoRequest struct {
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// Stream a not_found reply for unknown hashes instead of failing
+	IncludeNotFound      bool     `protobuf:"varint,2,opt,name=include_not_found,json=includeNotFound,proto3" json:"include_not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksInfoRequest) Reset()         { *m = BlocksInfoRequest{} }
+func (m *BlocksInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocksInfoRequest) ProtoMessage()    {}
+func (*BlocksInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{29}
+}
+
+func (m *BlocksInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksInfoRequest.Unmarshal(m, b)
+}
+func (m *BlocksInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *BlocksInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksInfoRequest.Merge(m, src)
+}
+func (m *BlocksInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_BlocksInfoRequest.Size(m)
+}
+func (m *BlocksInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksInfoRequest proto.InternalMessageInfo
+
+func (m *BlocksInfoRequest) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *BlocksInfoRequest) GetIncludeNotFound() bool {
+	if m != nil {
+		return m.IncludeNotFound
+	}
+	return false
+}
+
+type BlocksInfoReply struct {
+	BlockHash string          `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Block     *BlockInfoReply `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Set instead of block for unknown hashes when include_not_found is requested
+	NotFound             bool     `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksInfoReply) Reset()         { *m = BlocksInfoReply{} }
+func (m *BlocksInfoReply) String() string { return proto.CompactTextString(m) }
+func (*BlocksInfoReply) ProtoMessage()    {}
+func (*BlocksInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{30}
+}
+
+func (m *BlocksInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksInfoReply.Unmarshal(m, b)
+}
+func (m *BlocksInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksInfoReply.Marshal(b, m, deterministic)
+}
+func (m *BlocksInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksInfoReply.Merge(m, src)
+}
+func (m *BlocksInfoReply) XXX_Size() int {
+	return xxx_messageInfo_BlocksInfoReply.Size(m)
+}
+func (m *BlocksInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksInfoReply proto.InternalMessageInfo
+
+func (m *BlocksInfoReply) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *BlocksInfoReply) GetBlock() *BlockInfoReply {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlocksInfoReply) GetNotFound() bool {
+	if m != nil {
+		return m.NotFound
+	}
+	return false
+}
+
+type SubscribeRequest struct {
+	Accounts []string     `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Match    AccountMatch `protobuf:"varint,2,opt,name=match,proto3,enum=nanoproto.AccountMatch" json:"match,omitempty"`
+	// Block subtypes to deliver: send, receive, change, epoch. Empty for all.
+	Subtypes []string `protobuf:"bytes,3,rep,name=subtypes,proto3" json:"subtypes,omitempty"`
+	// Minimum amount in raw. Empty for no minimum.
+	MinAmount string         `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Overflow  OverflowPolicy `protobuf:"varint,5,opt,name=overflow,proto3,enum=nanoproto.OverflowPolicy" json:"overflow,omitempty"`
+	// Sequence of the last entry received on a previous stream. Missed entries
+	// are replayed before the live ones. OUT_OF_RANGE if they are no longer
+	// retained, or if the sequence is ahead of the gateway journal. Unless the
+	// gateway journals every account, the confirmations of accounts no stream
+	// was subscribed to are not retained.
+	ResumeFrom           uint64   `protobuf:"varint,6,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{31}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetMatch() AccountMatch {
+	if m != nil {
+		return m.Match
+	}
+	return AccountMatch_RECIPIENT
+}
+
+func (m *SubscribeRequest) GetSubtypes() []string {
+	if m != nil {
+		return m.Subtypes
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetMinAmount() string {
+	if m != nil {
+		return m.MinAmount
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetOverflow() OverflowPolicy {
+	if m != nil {
+		return m.Overflow
+	}
+	return OverflowPolicy_DISCONNECT
+}
+
+func (m *SubscribeRequest) GetResumeFrom() uint64 {
+	if m != nil {
+		return m.ResumeFrom
+	}
+	return 0
+}
+
+type ElectionInfo struct {
+	Duration             string   `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Time                 string   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Tally                string   `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally,omitempty"`
+	RequestCount         string   `protobuf:"bytes,4,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ElectionInfo) Reset()         { *m = ElectionInfo{} }
+func (m *ElectionInfo) String() string { return proto.CompactTextString(m) }
+func (*ElectionInfo) ProtoMessage()    {}
+func (*ElectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{32}
+}
+
+func (m *ElectionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ElectionInfo.Unmarshal(m, b)
+}
+func (m *ElectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ElectionInfo.Marshal(b, m, deterministic)
+}
+func (m *ElectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionInfo.Merge(m, src)
+}
+func (m *ElectionInfo) XXX_Size() int {
+	return xxx_messageInfo_ElectionInfo.Size(m)
+}
+func (m *ElectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionInfo proto.InternalMessageInfo
+
+func (m *ElectionInfo) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *ElectionInfo) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *ElectionInfo) GetTally() string {
+	if m != nil {
+		return m.Tally
+	}
+	return ""
+}
+
+func (m *ElectionInfo) GetRequestCount() string {
+	if m != nil {
+		return m.RequestCount
+	}
+	return ""
+}
+
+type SubscriptionMessage struct {
+	Account              string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Signature            string             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Sequence             string             `protobuf:"bytes,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Blocks               []string           `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Amount               string             `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Hash                 string             `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	ConfirmationType     string             `protobuf:"bytes,7,opt,name=confirmation_type,json=confirmationType,proto3" json:"confirmation_type,omitempty"`
+	ElectionInfo         *ElectionInfo      `protobuf:"bytes,8,opt,name=election_info,json=electionInfo,proto3" json:"election_info,omitempty"`
+	Block                *SubscriptionBlock `protobuf:"bytes,9,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SubscriptionMessage) Reset()         { *m = SubscriptionMessage{} }
+func (m *SubscriptionMessage) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionMessage) ProtoMessage()    {}
+func (*SubscriptionMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{33}
+}
+
+func (m *SubscriptionMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionMessage.Unmarshal(m, b)
+}
+func (m *SubscriptionMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionMessage.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionMessage.Merge(m, src)
+}
+func (m *SubscriptionMessage) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionMessage.Size(m)
+}
+func (m *SubscriptionMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionMessage proto.InternalMessageInfo
+
+func (m *SubscriptionMessage) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetSequence() string {
+	if m != nil {
+		return m.Sequence
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetBlocks() []string {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *SubscriptionMessage) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetConfirmationType() string {
+	if m != nil {
+		return m.ConfirmationType
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetElectionInfo() *ElectionInfo {
+	if m != nil {
+		return m.ElectionInfo
+	}
+	return nil
+}
+
+func (m *SubscriptionMessage) GetBlock() *SubscriptionBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type SubscriptionBlock struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Representative       string   `protobuf:"bytes,3,opt,name=representative,proto3" json:"representative,omitempty"`
+	Account              string   `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Work                 string   `protobuf:"bytes,5,opt,name=work,proto3" json:"work,omitempty"`
+	Signature            string   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Previous             string   `protobuf:"bytes,7,opt,name=previous,proto3" json:"previous,omitempty"`
+	LinkAsAccount        string   `protobuf:"bytes,8,opt,name=link_as_account,json=linkAsAccount,proto3" json:"link_as_account,omitempty"`
+	Subtype              string   `protobuf:"bytes,9,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	Link                 string   `protobuf:"bytes,10,opt,name=link,proto3" json:"link,omitempty"`
+	Balance              string   `protobuf:"bytes,11,opt,name=balance,proto3" json:"balance,omitempty"`
+	Destination          string   `protobuf:"bytes,12,opt,name=destination,proto3" json:"destination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionBlock) Reset()         { *m = SubscriptionBlock{} }
+func (m *SubscriptionBlock) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionBlock) ProtoMessage()    {}
+func (*SubscriptionBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{34}
+}
+
+func (m *SubscriptionBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionBlock.Unmarshal(m, b)
+}
+func (m *SubscriptionBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionBlock.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionBlock.Merge(m, src)
+}
+func (m *SubscriptionBlock) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionBlock.Size(m)
+}
+func (m *SubscriptionBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionBlock proto.InternalMessageInfo
+
+func (m *SubscriptionBlock) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetLinkAsAccount() string {
+	if m != nil {
+		return m.LinkAsAccount
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// Entries with topic "status" carry no message, only a connection status change
+type SubscriptionEntry struct {
+	Topic   string               `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time    string               `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message *SubscriptionMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Status  ConnectionStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=nanoproto.ConnectionStatus" json:"status,omitempty"`
+	// Entries dropped so far on this stream because of DROP_OLDEST
+	Dropped uint64 `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// Gateway sequence number, increasing with every confirmation
+	Sequence             uint64   `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionEntry) Reset()         { *m = SubscriptionEntry{} }
+func (m *SubscriptionEntry) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionEntry) ProtoMessage()    {}
+func (*SubscriptionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{35}
+}
+
+func (m *SubscriptionEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionEntry.Unmarshal(m, b)
+}
+func (m *SubscriptionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionEntry.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionEntry.Merge(m, src)
+}
+func (m *SubscriptionEntry) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionEntry.Size(m)
+}
+func (m *SubscriptionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionEntry proto.InternalMessageInfo
+
+func (m *SubscriptionEntry) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *SubscriptionEntry) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *SubscriptionEntry) GetMessage() *SubscriptionMessage {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-type Balance struct {
-	Balance              string   `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	Pending              string   `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+func (m *SubscriptionEntry) GetStatus() ConnectionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ConnectionStatus_CONNECTED
+}
+
+func (m *SubscriptionEntry) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *SubscriptionEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type TopicRequest struct {
+	Overflow             OverflowPolicy `protobuf:"varint,1,opt,name=overflow,proto3,enum=nanoproto.OverflowPolicy" json:"overflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TopicRequest) Reset()         { *m = TopicRequest{} }
+func (m *TopicRequest) String() string { return proto.CompactTextString(m) }
+func (*TopicRequest) ProtoMessage()    {}
+func (*TopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{36}
+}
+
+func (m *TopicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopicRequest.Unmarshal(m, b)
+}
+func (m *TopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopicRequest.Marshal(b, m, deterministic)
+}
+func (m *TopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicRequest.Merge(m, src)
+}
+func (m *TopicRequest) XXX_Size() int {
+	return xxx_messageInfo_TopicRequest.Size(m)
+}
+func (m *TopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicRequest proto.InternalMessageInfo
+
+func (m *TopicRequest) GetOverflow() OverflowPolicy {
+	if m != nil {
+		return m.Overflow
+	}
+	return OverflowPolicy_DISCONNECT
+}
+
+type SubscribeVotesRequest struct {
+	// Representatives whose votes are delivered. Empty for all.
+	Representatives      []string       `protobuf:"bytes,1,rep,name=representatives,proto3" json:"representatives,omitempty"`
+	Overflow             OverflowPolicy `protobuf:"varint,2,opt,name=overflow,proto3,enum=nanoproto.OverflowPolicy" json:"overflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SubscribeVotesRequest) Reset()         { *m = SubscribeVotesRequest{} }
+func (m *SubscribeVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeVotesRequest) ProtoMessage()    {}
+func (*SubscribeVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{37}
+}
+
+func (m *SubscribeVotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeVotesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeVotesRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeVotesRequest.Merge(m, src)
+}
+func (m *SubscribeVotesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeVotesRequest.Size(m)
+}
+func (m *SubscribeVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeVotesRequest proto.InternalMessageInfo
+
+func (m *SubscribeVotesRequest) GetRepresentatives() []string {
+	if m != nil {
+		return m.Representatives
+	}
+	return nil
+}
+
+func (m *SubscribeVotesRequest) GetOverflow() OverflowPolicy {
+	if m != nil {
+		return m.Overflow
+	}
+	return OverflowPolicy_DISCONNECT
+}
+
+type VoteMessage struct {
+	Account   string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Signature string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Sequence  string   `protobuf:"bytes,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Blocks    []string `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// vote, replay or indeterminate
+	Type                 string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Balance) Reset()         { *m = Balance{} }
-func (m *Balance) String() string { return proto.CompactTextString(m) }
-func (*Balance) ProtoMessage()    {}
-func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{9}
+func (m *VoteMessage) Reset()         { *m = VoteMessage{} }
+func (m *VoteMessage) String() string { return proto.CompactTextString(m) }
+func (*VoteMessage) ProtoMessage()    {}
+func (*VoteMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{38}
 }
 
-func (m *Balance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Balance.Unmarshal(m, b)
+func (m *VoteMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteMessage.Unmarshal(m, b)
 }
-func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+func (m *VoteMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteMessage.Marshal(b, m, deterministic)
 }
-func (m *Balance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Balance.Merge(m, src)
+func (m *VoteMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteMessage.Merge(m, src)
 }
-func (m *Balance) XXX_Size() int {
-	return xxx_messageInfo_Balance.Size(m)
+func (m *VoteMessage) XXX_Size() int {
+	return xxx_messageInfo_VoteMessage.Size(m)
 }
-func (m *Balance) XXX_DiscardUnknown() {
-	xxx_messageInfo_Balance.DiscardUnknown(m)
+func (m *VoteMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteMessage proto.InternalMessageInfo
+
+func (m *VoteMessage) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *VoteMessage) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *VoteMessage) GetSequence() string {
+	if m != nil {
+		return m.Sequence
+	}
+	return ""
 }
 
-var xxx_messageInfo_Balance proto.InternalMessageInfo
-
-func (m *Balance) GetBalance() string {
+func (m *VoteMessage) GetBlocks() []string {
 	if m != nil {
-		return m.Balance
+		return m.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (m *Balance) GetPending() string {
+func (m *VoteMessage) GetType() string {
 	if m != nil {
-		return m.Pending
+		return m.Type
 	}
 	return ""
 }
 
-type AccountsBalancesReply struct {
-	Balances             map[string]*Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type VoteEntry struct {
+	Topic                string       `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string       `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *VoteMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *AccountsBalancesReply) Reset()         { *m = AccountsBalancesReply{} }
-func (m *AccountsBalancesReply) String() string { return proto.CompactTextString(m) }
-func (*AccountsBalancesReply) ProtoMessage()    {}
-func (*AccountsBalancesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{10}
+func (m *VoteEntry) Reset()         { *m = VoteEntry{} }
+func (m *VoteEntry) String() string { return proto.CompactTextString(m) }
+func (*VoteEntry) ProtoMessage()    {}
+func (*VoteEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{39}
 }
 
-func (m *AccountsBalancesReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountsBalancesReply.Unmarshal(m, b)
+func (m *VoteEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteEntry.Unmarshal(m, b)
 }
-func (m *AccountsBalancesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountsBalancesReply.Marshal(b, m, deterministic)
+func (m *VoteEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteEntry.Marshal(b, m, deterministic)
 }
-func (m *AccountsBalancesReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountsBalancesReply.Merge(m, src)
+func (m *VoteEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteEntry.Merge(m, src)
 }
-func (m *AccountsBalancesReply) XXX_Size() int {
-	return xxx_messageInfo_AccountsBalancesReply.Size(m)
+func (m *VoteEntry) XXX_Size() int {
+	return xxx_messageInfo_VoteEntry.Size(m)
 }
-func (m *AccountsBalancesReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountsBalancesReply.DiscardUnknown(m)
+func (m *VoteEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountsBalancesReply proto.InternalMessageInfo
+var xxx_messageInfo_VoteEntry proto.InternalMessageInfo
 
-func (m *AccountsBalancesReply) GetBalances() map[string]*Balance {
+func (m *VoteEntry) GetTopic() string {
 	if m != nil {
-		return m.Balances
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *VoteEntry) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *VoteEntry) GetMessage() *VoteMessage {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-// BlockInfo Request
-type BlockInfoRequest struct {
+type StoppedElectionMessage struct {
 	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockInfoRequest) Reset()         { *m = BlockInfoRequest{} }
-func (m *BlockInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*BlockInfoRequest) ProtoMessage()    {}
-func (*BlockInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{11}
+func (m *StoppedElectionMessage) Reset()         { *m = StoppedElectionMessage{} }
+func (m *StoppedElectionMessage) String() string { return proto.CompactTextString(m) }
+func (*StoppedElectionMessage) ProtoMessage()    {}
+func (*StoppedElectionMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{40}
 }
 
-func (m *BlockInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockInfoRequest.Unmarshal(m, b)
+func (m *StoppedElectionMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoppedElectionMessage.Unmarshal(m, b)
 }
-func (m *BlockInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockInfoRequest.Marshal(b, m, deterministic)
+func (m *StoppedElectionMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoppedElectionMessage.Marshal(b, m, deterministic)
 }
-func (m *BlockInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockInfoRequest.Merge(m, src)
+func (m *StoppedElectionMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoppedElectionMessage.Merge(m, src)
 }
-func (m *BlockInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_BlockInfoRequest.Size(m)
+func (m *StoppedElectionMessage) XXX_Size() int {
+	return xxx_messageInfo_StoppedElectionMessage.Size(m)
 }
-func (m *BlockInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockInfoRequest.DiscardUnknown(m)
+func (m *StoppedElectionMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoppedElectionMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_StoppedElectionMessage proto.InternalMessageInfo
 
-func (m *BlockInfoRequest) GetHash() string {
+func (m *StoppedElectionMessage) GetHash() string {
 	if m != nil {
 		return m.Hash
 	}
 	return ""
 }
 
-type BlockInfoReply struct {
-	BlockAccount         string         `protobuf:"bytes,1,opt,name=block_account,json=blockAccount,proto3" json:"block_account,omitempty"`
-	Amount               string         `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Balance              string         `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
-	Height               string         `protobuf:"bytes,4,opt,name=height,proto3" json:"height,omitempty"`
-	LocalTimestamp       string         `protobuf:"bytes,5,opt,name=local_timestamp,json=localTimestamp,proto3" json:"local_timestamp,omitempty"`
-	Confirmed            string         `protobuf:"bytes,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
-	Contents             *BlockContents `protobuf:"bytes,7,opt,name=contents,proto3" json:"contents,omitempty"`
-	Subtype              string         `protobuf:"bytes,8,opt,name=subtype,proto3" json:"subtype,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type StoppedElectionEntry struct {
+	Topic                string                  `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string                  `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *StoppedElectionMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *BlockInfoReply) Reset()         { *m = BlockInfoReply{} }
-func (m *BlockInfoReply) String() string { return proto.CompactTextString(m) }
-func (*BlockInfoReply) ProtoMessage()    {}
-func (*BlockInfoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{12}
+func (m *StoppedElectionEntry) Reset()         { *m = StoppedElectionEntry{} }
+func (m *StoppedElectionEntry) String() string { return proto.CompactTextString(m) }
+func (*StoppedElectionEntry) ProtoMessage()    {}
+func (*StoppedElectionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{41}
 }
 
-func (m *BlockInfoReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockInfoReply.Unmarshal(m, b)
+func (m *StoppedElectionEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoppedElectionEntry.Unmarshal(m, b)
 }
-func (m *BlockInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockInfoReply.Marshal(b, m, deterministic)
+func (m *StoppedElectionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoppedElectionEntry.Marshal(b, m, deterministic)
 }
-func (m *BlockInfoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockInfoReply.Merge(m, src)
+func (m *StoppedElectionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoppedElectionEntry.Merge(m, src)
 }
-func (m *BlockInfoReply) XXX_Size() int {
-	return xxx_messageInfo_BlockInfoReply.Size(m)
+func (m *StoppedElectionEntry) XXX_Size() int {
+	return xxx_messageInfo_StoppedElectionEntry.Size(m)
 }
-func (m *BlockInfoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockInfoReply.DiscardUnknown(m)
+func (m *StoppedElectionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoppedElectionEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockInfoReply proto.InternalMessageInfo
+var xxx_messageInfo_StoppedElectionEntry proto.InternalMessageInfo
 
-func (m *BlockInfoReply) GetBlockAccount() string {
+func (m *StoppedElectionEntry) GetTopic() string {
 	if m != nil {
-		return m.BlockAccount
+		return m.Topic
 	}
 	return ""
 }
 
-func (m *BlockInfoReply) GetAmount() string {
+func (m *StoppedElectionEntry) GetTime() string {
 	if m != nil {
-		return m.Amount
+		return m.Time
 	}
 	return ""
 }
 
-func (m *BlockInfoReply) GetBalance() string {
+func (m *StoppedElectionEntry) GetMessage() *StoppedElectionMessage {
 	if m != nil {
-		return m.Balance
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *BlockInfoReply) GetHeight() string {
+type ActiveDifficultyMessage struct {
+	Multiplier            string   `protobuf:"bytes,1,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	NetworkCurrent        string   `protobuf:"bytes,2,opt,name=network_current,json=networkCurrent,proto3" json:"network_current,omitempty"`
+	NetworkMinimum        string   `protobuf:"bytes,3,opt,name=network_minimum,json=networkMinimum,proto3" json:"network_minimum,omitempty"`
+	NetworkReceiveCurrent string   `protobuf:"bytes,4,opt,name=network_receive_current,json=networkReceiveCurrent,proto3" json:"network_receive_current,omitempty"`
+	NetworkReceiveMinimum string   `protobuf:"bytes,5,opt,name=network_receive_minimum,json=networkReceiveMinimum,proto3" json:"network_receive_minimum,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ActiveDifficultyMessage) Reset()         { *m = ActiveDifficultyMessage{} }
+func (m *ActiveDifficultyMessage) String() string { return proto.CompactTextString(m) }
+func (*ActiveDifficultyMessage) ProtoMessage()    {}
+func (*ActiveDifficultyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{42}
+}
+
+func (m *ActiveDifficultyMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveDifficultyMessage.Unmarshal(m, b)
+}
+func (m *ActiveDifficultyMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveDifficultyMessage.Marshal(b, m, deterministic)
+}
+func (m *ActiveDifficultyMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveDifficultyMessage.Merge(m, src)
+}
+func (m *ActiveDifficultyMessage) XXX_Size() int {
+	return xxx_messageInfo_ActiveDifficultyMessage.Size(m)
+}
+func (m *ActiveDifficultyMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveDifficultyMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveDifficultyMessage proto.InternalMessageInfo
+
+func (m *ActiveDifficultyMessage) GetMultiplier() string {
 	if m != nil {
-		return m.Height
+		return m.Multiplier
 	}
 	return ""
 }
 
-func (m *BlockInfoReply) GetLocalTimestamp() string {
+func (m *ActiveDifficultyMessage) GetNetworkCurrent() string {
 	if m != nil {
-		return m.LocalTimestamp
+		return m.NetworkCurrent
 	}
 	return ""
 }
 
-func (m *BlockInfoReply) GetConfirmed() string {
+func (m *ActiveDifficultyMessage) GetNetworkMinimum() string {
 	if m != nil {
-		return m.Confirmed
+		return m.NetworkMinimum
 	}
 	return ""
 }
 
-func (m *BlockInfoReply) GetContents() *BlockContents {
+func (m *ActiveDifficultyMessage) GetNetworkReceiveCurrent() string {
 	if m != nil {
-		return m.Contents
+		return m.NetworkReceiveCurrent
 	}
-	return nil
+	return ""
 }
 
-func (m *BlockInfoReply) GetSubtype() string {
+func (m *ActiveDifficultyMessage) GetNetworkReceiveMinimum() string {
 	if m != nil {
-		return m.Subtype
+		return m.NetworkReceiveMinimum
 	}
 	return ""
 }
 
-type BlockContents struct {
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Previous             string   `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
-	Representative       string   `protobuf:"bytes,4,opt,name=representative,proto3" json:"representative,omitempty"`
-	Balance              string   `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
-	Link                 string   `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
-	LinkAsAccount        string   `protobuf:"bytes,7,opt,name=link_as_account,json=linkAsAccount,proto3" json:"link_as_account,omitempty"`
-	Signature            string   `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
-	Work                 string   `protobuf:"bytes,9,opt,name=work,proto3" json:"work,omitempty"`
-	Subtype              string   `protobuf:"bytes,10,opt,name=subtype,proto3" json:"subtype,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ActiveDifficultyEntry struct {
+	Topic                string                   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string                   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *ActiveDifficultyMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *BlockContents) Reset()         { *m = BlockContents{} }
-func (m *BlockContents) String() string { return proto.CompactTextString(m) }
-func (*BlockContents) ProtoMessage()    {}
-func (*BlockContents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{13}
+func (m *ActiveDifficultyEntry) Reset()         { *m = ActiveDifficultyEntry{} }
+func (m *ActiveDifficultyEntry) String() string { return proto.CompactTextString(m) }
+func (*ActiveDifficultyEntry) ProtoMessage()    {}
+func (*ActiveDifficultyEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{43}
 }
 
-func (m *BlockContents) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockContents.Unmarshal(m, b)
+func (m *ActiveDifficultyEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveDifficultyEntry.Unmarshal(m, b)
 }
-func (m *BlockContents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockContents.Marshal(b, m, deterministic)
+func (m *ActiveDifficultyEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveDifficultyEntry.Marshal(b, m, deterministic)
 }
-func (m *BlockContents) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockContents.Merge(m, src)
+func (m *ActiveDifficultyEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveDifficultyEntry.Merge(m, src)
 }
-func (m *BlockContents) XXX_Size() int {
-	return xxx_messageInfo_BlockContents.Size(m)
+func (m *ActiveDifficultyEntry) XXX_Size() int {
+	return xxx_messageInfo_ActiveDifficultyEntry.Size(m)
 }
-func (m *BlockContents) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockContents.DiscardUnknown(m)
+func (m *ActiveDifficultyEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveDifficultyEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockContents proto.InternalMessageInfo
+var xxx_messageInfo_ActiveDifficultyEntry proto.InternalMessageInfo
 
-func (m *BlockContents) GetType() string {
+func (m *ActiveDifficultyEntry) GetTopic() string {
 	if m != nil {
-		return m.Type
+		return m.Topic
 	}
 	return ""
 }
 
-func (m *BlockContents) GetAccount() string {
+func (m *ActiveDifficultyEntry) GetTime() string {
 	if m != nil {
-		return m.Account
+		return m.Time
 	}
 	return ""
 }
 
-func (m *BlockContents) GetPrevious() string {
+func (m *ActiveDifficultyEntry) GetMessage() *ActiveDifficultyMessage {
 	if m != nil {
-		return m.Previous
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *BlockContents) GetRepresentative() string {
+type WorkRequest struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Difficulty           string   `protobuf:"bytes,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Multiplier           string   `protobuf:"bytes,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkRequest) Reset()         { *m = WorkRequest{} }
+func (m *WorkRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkRequest) ProtoMessage()    {}
+func (*WorkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{44}
+}
+
+func (m *WorkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkRequest.Unmarshal(m, b)
+}
+func (m *WorkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkRequest.Merge(m, src)
+}
+func (m *WorkRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkRequest.Size(m)
+}
+func (m *WorkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkRequest proto.InternalMessageInfo
+
+func (m *WorkRequest) GetVersion() string {
 	if m != nil {
-		return m.Representative
+		return m.Version
 	}
 	return ""
 }
 
-func (m *BlockContents) GetBalance() string {
+func (m *WorkRequest) GetHash() string {
 	if m != nil {
-		return m.Balance
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *BlockContents) GetLink() string {
+func (m *WorkRequest) GetDifficulty() string {
 	if m != nil {
-		return m.Link
+		return m.Difficulty
 	}
 	return ""
 }
 
-func (m *BlockContents) GetLinkAsAccount() string {
+func (m *WorkRequest) GetMultiplier() string {
 	if m != nil {
-		return m.LinkAsAccount
+		return m.Multiplier
 	}
 	return ""
 }
 
-func (m *BlockContents) GetSignature() string {
+type WorkResult struct {
+	Source               string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Work                 string   `protobuf:"bytes,2,opt,name=work,proto3" json:"work,omitempty"`
+	Difficulty           string   `protobuf:"bytes,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Multiplier           string   `protobuf:"bytes,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkResult) Reset()         { *m = WorkResult{} }
+func (m *WorkResult) String() string { return proto.CompactTextString(m) }
+func (*WorkResult) ProtoMessage()    {}
+func (*WorkResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{45}
+}
+
+func (m *WorkResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkResult.Unmarshal(m, b)
+}
+func (m *WorkResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkResult.Marshal(b, m, deterministic)
+}
+func (m *WorkResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkResult.Merge(m, src)
+}
+func (m *WorkResult) XXX_Size() int {
+	return xxx_messageInfo_WorkResult.Size(m)
+}
+func (m *WorkResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkResult proto.InternalMessageInfo
+
+func (m *WorkResult) GetSource() string {
 	if m != nil {
-		return m.Signature
+		return m.Source
 	}
 	return ""
 }
 
-func (m *BlockContents) GetWork() string {
+func (m *WorkResult) GetWork() string {
 	if m != nil {
 		return m.Work
 	}
 	return ""
 }
 
-func (m *BlockContents) GetSubtype() string {
+func (m *WorkResult) GetDifficulty() string {
 	if m != nil {
-		return m.Subtype
+		return m.Difficulty
 	}
 	return ""
 }
 
-type BlocksInfoRequest struct {
-	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WorkResult) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
 }
 
-func (m *BlocksInfoRequest) Reset()         { *m = BlocksInfoRequest{} }
-func (m *BlocksInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*BlocksInfoRequest) ProtoMessage()    {}
-func (*BlocksInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{14}
+type WorkMessage struct {
+	Success              string       `protobuf:"bytes,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reason               string       `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration             string       `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Request              *WorkRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	Result               *WorkResult  `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	BadPeers             []string     `protobuf:"bytes,6,rep,name=bad_peers,json=badPeers,proto3" json:"bad_peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *BlocksInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlocksInfoRequest.Unmarshal(m, b)
+func (m *WorkMessage) Reset()         { *m = WorkMessage{} }
+func (m *WorkMessage) String() string { return proto.CompactTextString(m) }
+func (*WorkMessage) ProtoMessage()    {}
+func (*WorkMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{46}
 }
-func (m *BlocksInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlocksInfoRequest.Marshal(b, m, deterministic)
+
+func (m *WorkMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkMessage.Unmarshal(m, b)
 }
-func (m *BlocksInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlocksInfoRequest.Merge(m, src)
+func (m *WorkMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkMessage.Marshal(b, m, deterministic)
 }
-func (m *BlocksInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_BlocksInfoRequest.Size(m)
+func (m *WorkMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkMessage.Merge(m, src)
 }
-func (m *BlocksInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlocksInfoRequest.DiscardUnknown(m)
+func (m *WorkMessage) XXX_Size() int {
+	return xxx_messageInfo_WorkMessage.Size(m)
+}
+func (m *WorkMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkMessage proto.InternalMessageInfo
+
+func (m *WorkMessage) GetSuccess() string {
+	if m != nil {
+		return m.Success
+	}
+	return ""
+}
+
+func (m *WorkMessage) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkMessage) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *WorkMessage) GetRequest() *WorkRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-var xxx_messageInfo_BlocksInfoRequest proto.InternalMessageInfo
+func (m *WorkMessage) GetResult() *WorkResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
 
-func (m *BlocksInfoRequest) GetHashes() []string {
+func (m *WorkMessage) GetBadPeers() []string {
 	if m != nil {
-		return m.Hashes
+		return m.BadPeers
 	}
 	return nil
 }
 
-type BlocksInfoReply struct {
-	BlockHash            string          `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Block                *BlockInfoReply `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type WorkEntry struct {
+	Topic                string       `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string       `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *WorkMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *BlocksInfoReply) Reset()         { *m = BlocksInfoReply{} }
-func (m *BlocksInfoReply) String() string { return proto.CompactTextString(m) }
-func (*BlocksInfoReply) ProtoMessage()    {}
-func (*BlocksInfoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{15}
+func (m *WorkEntry) Reset()         { *m = WorkEntry{} }
+func (m *WorkEntry) String() string { return proto.CompactTextString(m) }
+func (*WorkEntry) ProtoMessage()    {}
+func (*WorkEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{47}
 }
 
-func (m *BlocksInfoReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlocksInfoReply.Unmarshal(m, b)
+func (m *WorkEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkEntry.Unmarshal(m, b)
 }
-func (m *BlocksInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlocksInfoReply.Marshal(b, m, deterministic)
+func (m *WorkEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkEntry.Marshal(b, m, deterministic)
 }
-func (m *BlocksInfoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlocksInfoReply.Merge(m, src)
+func (m *WorkEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkEntry.Merge(m, src)
 }
-func (m *BlocksInfoReply) XXX_Size() int {
-	return xxx_messageInfo_BlocksInfoReply.Size(m)
+func (m *WorkEntry) XXX_Size() int {
+	return xxx_messageInfo_WorkEntry.Size(m)
 }
-func (m *BlocksInfoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlocksInfoReply.DiscardUnknown(m)
+func (m *WorkEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlocksInfoReply proto.InternalMessageInfo
+var xxx_messageInfo_WorkEntry proto.InternalMessageInfo
 
-func (m *BlocksInfoReply) GetBlockHash() string {
+func (m *WorkEntry) GetTopic() string {
 	if m != nil {
-		return m.BlockHash
+		return m.Topic
 	}
 	return ""
 }
 
-func (m *BlocksInfoReply) GetBlock() *BlockInfoReply {
+func (m *WorkEntry) GetTime() string {
 	if m != nil {
-		return m.Block
+		return m.Time
+	}
+	return ""
+}
+
+func (m *WorkEntry) GetMessage() *WorkMessage {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-type SubscribeRequest struct {
-	Accounts             []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+type TelemetryMessage struct {
+	BlockCount           string   `protobuf:"bytes,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	CementedCount        string   `protobuf:"bytes,2,opt,name=cemented_count,json=cementedCount,proto3" json:"cemented_count,omitempty"`
+	UncheckedCount       string   `protobuf:"bytes,3,opt,name=unchecked_count,json=uncheckedCount,proto3" json:"unchecked_count,omitempty"`
+	AccountCount         string   `protobuf:"bytes,4,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+	BandwidthCap         string   `protobuf:"bytes,5,opt,name=bandwidth_cap,json=bandwidthCap,proto3" json:"bandwidth_cap,omitempty"`
+	PeerCount            string   `protobuf:"bytes,6,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	ProtocolVersion      string   `protobuf:"bytes,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Uptime               string   `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	GenesisBlock         string   `protobuf:"bytes,9,opt,name=genesis_block,json=genesisBlock,proto3" json:"genesis_block,omitempty"`
+	MajorVersion         string   `protobuf:"bytes,10,opt,name=major_version,json=majorVersion,proto3" json:"major_version,omitempty"`
+	MinorVersion         string   `protobuf:"bytes,11,opt,name=minor_version,json=minorVersion,proto3" json:"minor_version,omitempty"`
+	PatchVersion         string   `protobuf:"bytes,12,opt,name=patch_version,json=patchVersion,proto3" json:"patch_version,omitempty"`
+	PreReleaseVersion    string   `protobuf:"bytes,13,opt,name=pre_release_version,json=preReleaseVersion,proto3" json:"pre_release_version,omitempty"`
+	Maker                string   `protobuf:"bytes,14,opt,name=maker,proto3" json:"maker,omitempty"`
+	Timestamp            string   `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ActiveDifficulty     string   `protobuf:"bytes,16,opt,name=active_difficulty,json=activeDifficulty,proto3" json:"active_difficulty,omitempty"`
+	NodeId               string   `protobuf:"bytes,17,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Signature            string   `protobuf:"bytes,18,opt,name=signature,proto3" json:"signature,omitempty"`
+	Address              string   `protobuf:"bytes,19,opt,name=address,proto3" json:"address,omitempty"`
+	Port                 string   `protobuf:"bytes,20,opt,name=port,proto3" json:"port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
-func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeRequest) ProtoMessage()    {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{16}
+func (m *TelemetryMessage) Reset()         { *m = TelemetryMessage{} }
+func (m *TelemetryMessage) String() string { return proto.CompactTextString(m) }
+func (*TelemetryMessage) ProtoMessage()    {}
+func (*TelemetryMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{48}
 }
 
-func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+func (m *TelemetryMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryMessage.Unmarshal(m, b)
 }
-func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+func (m *TelemetryMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryMessage.Marshal(b, m, deterministic)
 }
-func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+func (m *TelemetryMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryMessage.Merge(m, src)
 }
-func (m *SubscribeRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeRequest.Size(m)
+func (m *TelemetryMessage) XXX_Size() int {
+	return xxx_messageInfo_TelemetryMessage.Size(m)
 }
-func (m *SubscribeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+func (m *TelemetryMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+var xxx_messageInfo_TelemetryMessage proto.InternalMessageInfo
 
-func (m *SubscribeRequest) GetAccounts() []string {
+func (m *TelemetryMessage) GetBlockCount() string {
 	if m != nil {
-		return m.Accounts
+		return m.BlockCount
 	}
-	return nil
+	return ""
 }
 
-type ElectionInfo struct {
-	Duration             string   `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
-	Time                 string   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	Tally                string   `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally,omitempty"`
-	RequestCount         string   `protobuf:"bytes,4,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TelemetryMessage) GetCementedCount() string {
+	if m != nil {
+		return m.CementedCount
+	}
+	return ""
 }
 
-func (m *ElectionInfo) Reset()         { *m = ElectionInfo{} }
-func (m *ElectionInfo) String() string { return proto.CompactTextString(m) }
-func (*ElectionInfo) ProtoMessage()    {}
-func (*ElectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{17}
+func (m *TelemetryMessage) GetUncheckedCount() string {
+	if m != nil {
+		return m.UncheckedCount
+	}
+	return ""
 }
 
-func (m *ElectionInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ElectionInfo.Unmarshal(m, b)
-}
-func (m *ElectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ElectionInfo.Marshal(b, m, deterministic)
-}
-func (m *ElectionInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ElectionInfo.Merge(m, src)
-}
-func (m *ElectionInfo) XXX_Size() int {
-	return xxx_messageInfo_ElectionInfo.Size(m)
-}
-func (m *ElectionInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ElectionInfo.DiscardUnknown(m)
+func (m *TelemetryMessage) GetAccountCount() string {
+	if m != nil {
+		return m.AccountCount
+	}
+	return ""
 }
 
-var xxx_messageInfo_ElectionInfo proto.InternalMessageInfo
-
-func (m *ElectionInfo) GetDuration() string {
+func (m *TelemetryMessage) GetBandwidthCap() string {
 	if m != nil {
-		return m.Duration
+		return m.BandwidthCap
 	}
 	return ""
 }
 
-func (m *ElectionInfo) GetTime() string {
+func (m *TelemetryMessage) GetPeerCount() string {
 	if m != nil {
-		return m.Time
+		return m.PeerCount
 	}
 	return ""
 }
 
-func (m *ElectionInfo) GetTally() string {
+func (m *TelemetryMessage) GetProtocolVersion() string {
 	if m != nil {
-		return m.Tally
+		return m.ProtocolVersion
 	}
 	return ""
 }
 
-func (m *ElectionInfo) GetRequestCount() string {
+func (m *TelemetryMessage) GetUptime() string {
 	if m != nil {
-		return m.RequestCount
+		return m.Uptime
 	}
 	return ""
 }
 
-type SubscriptionMessage struct {
-	Account              string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Signature            string             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Sequence             string             `protobuf:"bytes,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Blocks               []string           `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"`
-	Amount               string             `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Hash                 string             `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
-	ConfirmationType     string             `protobuf:"bytes,7,opt,name=confirmation_type,json=confirmationType,proto3" json:"confirmation_type,omitempty"`
-	ElectionInfo         *ElectionInfo      `protobuf:"bytes,8,opt,name=election_info,json=electionInfo,proto3" json:"election_info,omitempty"`
-	Block                *SubscriptionBlock `protobuf:"bytes,9,opt,name=block,proto3" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *TelemetryMessage) GetGenesisBlock() string {
+	if m != nil {
+		return m.GenesisBlock
+	}
+	return ""
 }
 
-func (m *SubscriptionMessage) Reset()         { *m = SubscriptionMessage{} }
-func (m *SubscriptionMessage) String() string { return proto.CompactTextString(m) }
-func (*SubscriptionMessage) ProtoMessage()    {}
-func (*SubscriptionMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{18}
+func (m *TelemetryMessage) GetMajorVersion() string {
+	if m != nil {
+		return m.MajorVersion
+	}
+	return ""
 }
 
-func (m *SubscriptionMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscriptionMessage.Unmarshal(m, b)
-}
-func (m *SubscriptionMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscriptionMessage.Marshal(b, m, deterministic)
-}
-func (m *SubscriptionMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscriptionMessage.Merge(m, src)
-}
-func (m *SubscriptionMessage) XXX_Size() int {
-	return xxx_messageInfo_SubscriptionMessage.Size(m)
-}
-func (m *SubscriptionMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscriptionMessage.DiscardUnknown(m)
+func (m *TelemetryMessage) GetMinorVersion() string {
+	if m != nil {
+		return m.MinorVersion
+	}
+	return ""
 }
 
-var xxx_messageInfo_SubscriptionMessage proto.InternalMessageInfo
-
-func (m *SubscriptionMessage) GetAccount() string {
+func (m *TelemetryMessage) GetPatchVersion() string {
 	if m != nil {
-		return m.Account
+		return m.PatchVersion
 	}
 	return ""
 }
 
-func (m *SubscriptionMessage) GetSignature() string {
+func (m *TelemetryMessage) GetPreReleaseVersion() string {
 	if m != nil {
-		return m.Signature
+		return m.PreReleaseVersion
 	}
 	return ""
 }
 
-func (m *SubscriptionMessage) GetSequence() string {
+func (m *TelemetryMessage) GetMaker() string {
 	if m != nil {
-		return m.Sequence
+		return m.Maker
 	}
 	return ""
 }
 
-func (m *SubscriptionMessage) GetBlocks() []string {
+func (m *TelemetryMessage) GetTimestamp() string {
 	if m != nil {
-		return m.Blocks
+		return m.Timestamp
 	}
-	return nil
+	return ""
 }
 
-func (m *SubscriptionMessage) GetAmount() string {
+func (m *TelemetryMessage) GetActiveDifficulty() string {
 	if m != nil {
-		return m.Amount
+		return m.ActiveDifficulty
 	}
 	return ""
 }
 
-func (m *SubscriptionMessage) GetHash() string {
+func (m *TelemetryMessage) GetNodeId() string {
 	if m != nil {
-		return m.Hash
+		return m.NodeId
 	}
 	return ""
 }
 
-func (m *SubscriptionMessage) GetConfirmationType() string {
+func (m *TelemetryMessage) GetSignature() string {
 	if m != nil {
-		return m.ConfirmationType
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *SubscriptionMessage) GetElectionInfo() *ElectionInfo {
+func (m *TelemetryMessage) GetAddress() string {
 	if m != nil {
-		return m.ElectionInfo
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *SubscriptionMessage) GetBlock() *SubscriptionBlock {
+func (m *TelemetryMessage) GetPort() string {
 	if m != nil {
-		return m.Block
+		return m.Port
 	}
-	return nil
+	return ""
 }
 
-type SubscriptionBlock struct {
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Representative       string   `protobuf:"bytes,3,opt,name=representative,proto3" json:"representative,omitempty"`
-	Account              string   `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
-	Work                 string   `protobuf:"bytes,5,opt,name=work,proto3" json:"work,omitempty"`
-	Signature            string   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
-	Previous             string   `protobuf:"bytes,7,opt,name=previous,proto3" json:"previous,omitempty"`
-	LinkAsAccount        string   `protobuf:"bytes,8,opt,name=link_as_account,json=linkAsAccount,proto3" json:"link_as_account,omitempty"`
-	Subtype              string   `protobuf:"bytes,9,opt,name=subtype,proto3" json:"subtype,omitempty"`
-	Link                 string   `protobuf:"bytes,10,opt,name=link,proto3" json:"link,omitempty"`
-	Balance              string   `protobuf:"bytes,11,opt,name=balance,proto3" json:"balance,omitempty"`
-	Destination          string   `protobuf:"bytes,12,opt,name=destination,proto3" json:"destination,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type TelemetryEntry struct {
+	Topic                string            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string            `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *TelemetryMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *SubscriptionBlock) Reset()         { *m = SubscriptionBlock{} }
-func (m *SubscriptionBlock) String() string { return proto.CompactTextString(m) }
-func (*SubscriptionBlock) ProtoMessage()    {}
-func (*SubscriptionBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{19}
+func (m *TelemetryEntry) Reset()         { *m = TelemetryEntry{} }
+func (m *TelemetryEntry) String() string { return proto.CompactTextString(m) }
+func (*TelemetryEntry) ProtoMessage()    {}
+func (*TelemetryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{49}
 }
 
-func (m *SubscriptionBlock) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscriptionBlock.Unmarshal(m, b)
+func (m *TelemetryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryEntry.Unmarshal(m, b)
 }
-func (m *SubscriptionBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscriptionBlock.Marshal(b, m, deterministic)
+func (m *TelemetryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryEntry.Marshal(b, m, deterministic)
 }
-func (m *SubscriptionBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscriptionBlock.Merge(m, src)
+func (m *TelemetryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryEntry.Merge(m, src)
 }
-func (m *SubscriptionBlock) XXX_Size() int {
-	return xxx_messageInfo_SubscriptionBlock.Size(m)
+func (m *TelemetryEntry) XXX_Size() int {
+	return xxx_messageInfo_TelemetryEntry.Size(m)
 }
-func (m *SubscriptionBlock) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscriptionBlock.DiscardUnknown(m)
+func (m *TelemetryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscriptionBlock proto.InternalMessageInfo
+var xxx_messageInfo_TelemetryEntry proto.InternalMessageInfo
 
-func (m *SubscriptionBlock) GetType() string {
+func (m *TelemetryEntry) GetTopic() string {
 	if m != nil {
-		return m.Type
+		return m.Topic
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetSource() string {
+func (m *TelemetryEntry) GetTime() string {
 	if m != nil {
-		return m.Source
+		return m.Time
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetRepresentative() string {
+func (m *TelemetryEntry) GetMessage() *TelemetryMessage {
 	if m != nil {
-		return m.Representative
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *SubscriptionBlock) GetAccount() string {
+type NewUnconfirmedBlockEntry struct {
+	Topic                string             `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string             `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *SubscriptionBlock `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *NewUnconfirmedBlockEntry) Reset()         { *m = NewUnconfirmedBlockEntry{} }
+func (m *NewUnconfirmedBlockEntry) String() string { return proto.CompactTextString(m) }
+func (*NewUnconfirmedBlockEntry) ProtoMessage()    {}
+func (*NewUnconfirmedBlockEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{50}
+}
+
+func (m *NewUnconfirmedBlockEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewUnconfirmedBlockEntry.Unmarshal(m, b)
+}
+func (m *NewUnconfirmedBlockEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewUnconfirmedBlockEntry.Marshal(b, m, deterministic)
+}
+func (m *NewUnconfirmedBlockEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewUnconfirmedBlockEntry.Merge(m, src)
+}
+func (m *NewUnconfirmedBlockEntry) XXX_Size() int {
+	return xxx_messageInfo_NewUnconfirmedBlockEntry.Size(m)
+}
+func (m *NewUnconfirmedBlockEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewUnconfirmedBlockEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewUnconfirmedBlockEntry proto.InternalMessageInfo
+
+func (m *NewUnconfirmedBlockEntry) GetTopic() string {
 	if m != nil {
-		return m.Account
+		return m.Topic
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetWork() string {
+func (m *NewUnconfirmedBlockEntry) GetTime() string {
 	if m != nil {
-		return m.Work
+		return m.Time
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetSignature() string {
+func (m *NewUnconfirmedBlockEntry) GetMessage() *SubscriptionBlock {
 	if m != nil {
-		return m.Signature
+		return m.Message
 	}
-	return ""
+	return nil
+}
+
+type BootstrapMessage struct {
+	// started or exited
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Mode                 string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	TotalBlocks          string   `protobuf:"bytes,4,opt,name=total_blocks,json=totalBlocks,proto3" json:"total_blocks,omitempty"`
+	Duration             string   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BootstrapMessage) Reset()         { *m = BootstrapMessage{} }
+func (m *BootstrapMessage) String() string { return proto.CompactTextString(m) }
+func (*BootstrapMessage) ProtoMessage()    {}
+func (*BootstrapMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{51}
+}
+
+func (m *BootstrapMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BootstrapMessage.Unmarshal(m, b)
+}
+func (m *BootstrapMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BootstrapMessage.Marshal(b, m, deterministic)
+}
+func (m *BootstrapMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BootstrapMessage.Merge(m, src)
+}
+func (m *BootstrapMessage) XXX_Size() int {
+	return xxx_messageInfo_BootstrapMessage.Size(m)
 }
-
-func (m *SubscriptionBlock) GetPrevious() string {
-	if m != nil {
-		return m.Previous
-	}
-	return ""
+func (m *BootstrapMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BootstrapMessage.DiscardUnknown(m)
 }
 
-func (m *SubscriptionBlock) GetLinkAsAccount() string {
+var xxx_messageInfo_BootstrapMessage proto.InternalMessageInfo
+
+func (m *BootstrapMessage) GetReason() string {
 	if m != nil {
-		return m.LinkAsAccount
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetSubtype() string {
+func (m *BootstrapMessage) GetId() string {
 	if m != nil {
-		return m.Subtype
+		return m.Id
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetLink() string {
+func (m *BootstrapMessage) GetMode() string {
 	if m != nil {
-		return m.Link
+		return m.Mode
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetBalance() string {
+func (m *BootstrapMessage) GetTotalBlocks() string {
 	if m != nil {
-		return m.Balance
+		return m.TotalBlocks
 	}
 	return ""
 }
 
-func (m *SubscriptionBlock) GetDestination() string {
+func (m *BootstrapMessage) GetDuration() string {
 	if m != nil {
-		return m.Destination
+		return m.Duration
 	}
 	return ""
 }
 
-type SubscriptionEntry struct {
-	Topic                string               `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Time                 string               `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	Message              *SubscriptionMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type BootstrapEntry struct {
+	Topic                string            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Time                 string            `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message              *BootstrapMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *SubscriptionEntry) Reset()         { *m = SubscriptionEntry{} }
-func (m *SubscriptionEntry) String() string { return proto.CompactTextString(m) }
-func (*SubscriptionEntry) ProtoMessage()    {}
-func (*SubscriptionEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11bdccbcd58847bb, []int{20}
+func (m *BootstrapEntry) Reset()         { *m = BootstrapEntry{} }
+func (m *BootstrapEntry) String() string { return proto.CompactTextString(m) }
+func (*BootstrapEntry) ProtoMessage()    {}
+func (*BootstrapEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bdccbcd58847bb, []int{52}
 }
 
-func (m *SubscriptionEntry) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscriptionEntry.Unmarshal(m, b)
+func (m *BootstrapEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BootstrapEntry.Unmarshal(m, b)
 }
-func (m *SubscriptionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscriptionEntry.Marshal(b, m, deterministic)
+func (m *BootstrapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BootstrapEntry.Marshal(b, m, deterministic)
 }
-func (m *SubscriptionEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscriptionEntry.Merge(m, src)
+func (m *BootstrapEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BootstrapEntry.Merge(m, src)
 }
-func (m *SubscriptionEntry) XXX_Size() int {
-	return xxx_messageInfo_SubscriptionEntry.Size(m)
+func (m *BootstrapEntry) XXX_Size() int {
+	return xxx_messageInfo_BootstrapEntry.Size(m)
 }
-func (m *SubscriptionEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscriptionEntry.DiscardUnknown(m)
+func (m *BootstrapEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BootstrapEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscriptionEntry proto.InternalMessageInfo
+var xxx_messageInfo_BootstrapEntry proto.InternalMessageInfo
 
-func (m *SubscriptionEntry) GetTopic() string {
+func (m *BootstrapEntry) GetTopic() string {
 	if m != nil {
 		return m.Topic
 	}
 	return ""
 }
 
-func (m *SubscriptionEntry) GetTime() string {
+func (m *BootstrapEntry) GetTime() string {
 	if m != nil {
 		return m.Time
 	}
 	return ""
 }
 
-func (m *SubscriptionEntry) GetMessage() *SubscriptionMessage {
+func (m *BootstrapEntry) GetMessage() *BootstrapMessage {
 	if m != nil {
 		return m.Message
 	}
@@ -1217,14 +3629,33 @@ func (m *SubscriptionEntry) GetMessage() *SubscriptionMessage {
 }
 
 func init() {
+	proto.RegisterEnum("nanoproto.AccountMatch", AccountMatch_name, AccountMatch_value)
+	proto.RegisterEnum("nanoproto.OverflowPolicy", OverflowPolicy_name, OverflowPolicy_value)
+	proto.RegisterEnum("nanoproto.ConnectionStatus", ConnectionStatus_name, ConnectionStatus_value)
 	proto.RegisterType((*SendRequest)(nil), "nanoproto.SendRequest")
 	proto.RegisterType((*SendReply)(nil), "nanoproto.SendReply")
+	proto.RegisterType((*ReceiveRequest)(nil), "nanoproto.ReceiveRequest")
+	proto.RegisterType((*ReceiveReply)(nil), "nanoproto.ReceiveReply")
+	proto.RegisterType((*AccountsPendingRequest)(nil), "nanoproto.AccountsPendingRequest")
+	proto.RegisterType((*PendingBlock)(nil), "nanoproto.PendingBlock")
+	proto.RegisterType((*PendingBlocks)(nil), "nanoproto.PendingBlocks")
+	proto.RegisterType((*AccountsPendingReply)(nil), "nanoproto.AccountsPendingReply")
+	proto.RegisterMapType((map[string]*PendingBlocks)(nil), "nanoproto.AccountsPendingReply.BlocksEntry")
+	proto.RegisterType((*RawActionRequest)(nil), "nanoproto.RawActionRequest")
+	proto.RegisterType((*RawActionReply)(nil), "nanoproto.RawActionReply")
+	proto.RegisterType((*StatusRequest)(nil), "nanoproto.StatusRequest")
+	proto.RegisterType((*NodeStatus)(nil), "nanoproto.NodeStatus")
+	proto.RegisterType((*StatusReply)(nil), "nanoproto.StatusReply")
 	proto.RegisterType((*ValidateAccountNumberRequest)(nil), "nanoproto.ValidateAccountNumberRequest")
 	proto.RegisterType((*ValidateAccountNumberReply)(nil), "nanoproto.ValidateAccountNumberReply")
 	proto.RegisterType((*AccountCreateRequest)(nil), "nanoproto.AccountCreateRequest")
 	proto.RegisterType((*AccountCreateReply)(nil), "nanoproto.AccountCreateReply")
 	proto.RegisterType((*AccountBalanceRequest)(nil), "nanoproto.AccountBalanceRequest")
 	proto.RegisterType((*AccountBalanceReply)(nil), "nanoproto.AccountBalanceReply")
+	proto.RegisterType((*AccountInfoRequest)(nil), "nanoproto.AccountInfoRequest")
+	proto.RegisterType((*AccountInfoReply)(nil), "nanoproto.AccountInfoReply")
+	proto.RegisterType((*AccountHistoryRequest)(nil), "nanoproto.AccountHistoryRequest")
+	proto.RegisterType((*AccountHistoryEntry)(nil), "nanoproto.AccountHistoryEntry")
 	proto.RegisterType((*AccountsBalancesRequest)(nil), "nanoproto.AccountsBalancesRequest")
 	proto.RegisterType((*Balance)(nil), "nanoproto.Balance")
 	proto.RegisterType((*AccountsBalancesReply)(nil), "nanoproto.AccountsBalancesReply")
@@ -1239,84 +3670,230 @@ func init() {
 	proto.RegisterType((*SubscriptionMessage)(nil), "nanoproto.SubscriptionMessage")
 	proto.RegisterType((*SubscriptionBlock)(nil), "nanoproto.SubscriptionBlock")
 	proto.RegisterType((*SubscriptionEntry)(nil), "nanoproto.SubscriptionEntry")
+	proto.RegisterType((*TopicRequest)(nil), "nanoproto.TopicRequest")
+	proto.RegisterType((*SubscribeVotesRequest)(nil), "nanoproto.SubscribeVotesRequest")
+	proto.RegisterType((*VoteMessage)(nil), "nanoproto.VoteMessage")
+	proto.RegisterType((*VoteEntry)(nil), "nanoproto.VoteEntry")
+	proto.RegisterType((*StoppedElectionMessage)(nil), "nanoproto.StoppedElectionMessage")
+	proto.RegisterType((*StoppedElectionEntry)(nil), "nanoproto.StoppedElectionEntry")
+	proto.RegisterType((*ActiveDifficultyMessage)(nil), "nanoproto.ActiveDifficultyMessage")
+	proto.RegisterType((*ActiveDifficultyEntry)(nil), "nanoproto.ActiveDifficultyEntry")
+	proto.RegisterType((*WorkRequest)(nil), "nanoproto.WorkRequest")
+	proto.RegisterType((*WorkResult)(nil), "nanoproto.WorkResult")
+	proto.RegisterType((*WorkMessage)(nil), "nanoproto.WorkMessage")
+	proto.RegisterType((*WorkEntry)(nil), "nanoproto.WorkEntry")
+	proto.RegisterType((*TelemetryMessage)(nil), "nanoproto.TelemetryMessage")
+	proto.RegisterType((*TelemetryEntry)(nil), "nanoproto.TelemetryEntry")
+	proto.RegisterType((*NewUnconfirmedBlockEntry)(nil), "nanoproto.NewUnconfirmedBlockEntry")
+	proto.RegisterType((*BootstrapMessage)(nil), "nanoproto.BootstrapMessage")
+	proto.RegisterType((*BootstrapEntry)(nil), "nanoproto.BootstrapEntry")
 }
 
 func init() { proto.RegisterFile("nano.proto", fileDescriptor_11bdccbcd58847bb) }
 
 var fileDescriptor_11bdccbcd58847bb = []byte{
-	// 1138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xde, 0x38, 0xbf, 0x3e, 0x69, 0xda, 0x74, 0xb6, 0xdb, 0x35, 0xde, 0xec, 0x52, 0x8c, 0xe8,
-	0x56, 0x5a, 0xc9, 0x40, 0x80, 0x55, 0x85, 0xe0, 0x62, 0x53, 0x55, 0xea, 0x56, 0xb0, 0x2c, 0xd9,
-	0x8a, 0x95, 0xb8, 0x89, 0x26, 0xce, 0x34, 0xb1, 0xea, 0xd8, 0xc6, 0x63, 0x37, 0xe4, 0x21, 0xb8,
-	0xe5, 0x01, 0xe0, 0x15, 0x78, 0x0c, 0x5e, 0x82, 0x37, 0x41, 0xf3, 0xe7, 0x8c, 0x93, 0xb8, 0x85,
-	0xab, 0xce, 0x39, 0x73, 0x7e, 0xc6, 0xdf, 0x39, 0xe7, 0xcb, 0x29, 0x40, 0x88, 0xc3, 0xc8, 0x8d,
-	0x93, 0x28, 0x8d, 0x90, 0xc9, 0xce, 0xfc, 0xe8, 0x2c, 0xa0, 0xfd, 0x8e, 0x84, 0x93, 0x21, 0xf9,
-	0x25, 0x23, 0x34, 0x45, 0x87, 0xd0, 0x58, 0xe0, 0x20, 0x20, 0xa9, 0x55, 0x39, 0xaa, 0x9c, 0x98,
-	0x43, 0x29, 0x31, 0x3d, 0x8d, 0xb2, 0xc4, 0x23, 0x96, 0x21, 0xf4, 0x42, 0x42, 0x47, 0xd0, 0x9e,
-	0x10, 0x9a, 0xfa, 0x21, 0x4e, 0xfd, 0x28, 0xb4, 0xaa, 0xfc, 0x52, 0x57, 0x31, 0x4f, 0x3c, 0x8f,
-	0xb2, 0x30, 0xb5, 0x6a, 0xc2, 0x53, 0x48, 0xce, 0x47, 0x60, 0x8a, 0xc4, 0x71, 0xb0, 0x44, 0x07,
-	0x50, 0x1f, 0x07, 0x91, 0x77, 0x23, 0xb3, 0x0a, 0xc1, 0x39, 0x85, 0xde, 0x4f, 0x38, 0xf0, 0x27,
-	0x38, 0x25, 0xaf, 0x3c, 0x8f, 0x79, 0xbd, 0xc9, 0xe6, 0x63, 0x92, 0xa8, 0xc7, 0x5a, 0xd0, 0xc4,
-	0x42, 0x2f, 0xfd, 0x94, 0xe8, 0xf4, 0xc1, 0x2e, 0xf1, 0x94, 0xd9, 0x6e, 0xd9, 0xad, 0xca, 0xc6,
-	0x05, 0xc7, 0x85, 0x03, 0x69, 0x7b, 0x96, 0x10, 0x9c, 0x92, 0x7b, 0x20, 0x71, 0x5c, 0x40, 0x6b,
-	0xf6, 0x2c, 0x76, 0xf9, 0x9b, 0x3e, 0x87, 0x47, 0xd2, 0x7e, 0x80, 0x03, 0x1c, 0x7a, 0xe4, 0xfe,
-	0xcf, 0x78, 0x0d, 0x0f, 0xd7, 0x5d, 0x64, 0x8e, 0xb1, 0x90, 0x95, 0x83, 0x14, 0xd9, 0x4d, 0x4c,
-	0xc2, 0x89, 0x1f, 0x4e, 0x65, 0x9d, 0x94, 0xe8, 0x7c, 0x05, 0x8f, 0x65, 0x28, 0x2a, 0x63, 0x51,
-	0x95, 0xdf, 0x86, 0x96, 0x4c, 0x48, 0xad, 0xca, 0x51, 0xf5, 0xc4, 0x1c, 0xe6, 0xb2, 0xf3, 0x2d,
-	0x34, 0x07, 0xab, 0xd8, 0xff, 0x3b, 0xeb, 0x5f, 0x95, 0xfc, 0xa3, 0xb5, 0xb4, 0xec, 0x1b, 0x2e,
-	0xa1, 0x25, 0xdd, 0x45, 0xd2, 0x76, 0xdf, 0x75, 0xf3, 0xae, 0x74, 0xb7, 0xfa, 0xb8, 0x4a, 0x3a,
-	0x0f, 0xd3, 0x64, 0x39, 0xcc, 0xfd, 0xed, 0x1f, 0xa0, 0x53, 0xb8, 0x42, 0x5d, 0xa8, 0xde, 0x90,
-	0xa5, 0x7c, 0x26, 0x3b, 0xa2, 0x13, 0x5e, 0xf2, 0x4c, 0xb4, 0x6f, 0xbb, 0x8f, 0xb4, 0x5c, 0x0a,
-	0x5a, 0x61, 0xf0, 0xb5, 0x71, 0x5a, 0x71, 0x8e, 0xa1, 0x3b, 0x60, 0x1d, 0xf8, 0x3a, 0xbc, 0x8e,
-	0x14, 0x4a, 0x08, 0x6a, 0x33, 0x4c, 0x67, 0x32, 0x28, 0x3f, 0x3b, 0xbf, 0x1b, 0xb0, 0xab, 0x19,
-	0xb2, 0xef, 0xfa, 0x18, 0x3a, 0xbc, 0x79, 0x47, 0xc5, 0x92, 0xee, 0x70, 0xa5, 0xfc, 0x2c, 0x6d,
-	0x26, 0x0c, 0x7d, 0x26, 0x74, 0x88, 0xab, 0x45, 0x88, 0x0f, 0xa1, 0x31, 0x23, 0xfe, 0x74, 0x96,
-	0x4f, 0x91, 0x90, 0xd0, 0x73, 0xd8, 0x0b, 0x22, 0x0f, 0x07, 0xa3, 0xd4, 0x9f, 0x13, 0x9a, 0xe2,
-	0x79, 0x6c, 0xd5, 0xb9, 0xc1, 0x2e, 0x57, 0x5f, 0x29, 0x2d, 0xea, 0x81, 0xe9, 0x45, 0xe1, 0xb5,
-	0x9f, 0xcc, 0xc9, 0xc4, 0x6a, 0x70, 0x93, 0x95, 0x02, 0x7d, 0x09, 0x2d, 0x2f, 0x0a, 0x53, 0xc2,
-	0x5a, 0xa0, 0xc9, 0x11, 0xb2, 0x74, 0x84, 0xd8, 0xdb, 0xcf, 0xe4, 0xfd, 0x30, 0xb7, 0x64, 0xcf,
-	0xa5, 0xd9, 0x38, 0x5d, 0xc6, 0xc4, 0x6a, 0x89, 0xe7, 0x4a, 0xd1, 0xf9, 0xd3, 0x80, 0x4e, 0xc1,
-	0x8b, 0xc1, 0xc7, 0x0d, 0x25, 0x7c, 0xec, 0xac, 0x37, 0xbe, 0x51, 0x68, 0x7c, 0xd6, 0x92, 0x71,
-	0x42, 0x6e, 0xfd, 0x28, 0xa3, 0x12, 0x89, 0x5c, 0x46, 0xc7, 0xb0, 0x9b, 0x90, 0x38, 0x21, 0x94,
-	0x84, 0x29, 0x4e, 0xfd, 0x5b, 0x22, 0x21, 0x59, 0xd3, 0xea, 0x60, 0xd6, 0x8b, 0x60, 0x22, 0xa8,
-	0x05, 0x7e, 0x78, 0x23, 0x61, 0xe0, 0x67, 0x74, 0x0c, 0x7b, 0xec, 0xef, 0x08, 0xd3, 0xbc, 0x72,
-	0x4d, 0x7e, 0xdd, 0x61, 0xea, 0x57, 0x54, 0x95, 0xae, 0x07, 0x26, 0xf5, 0xa7, 0x21, 0x4e, 0xb3,
-	0x44, 0x7d, 0xf5, 0x4a, 0xc1, 0x22, 0x2f, 0xa2, 0xe4, 0xc6, 0x32, 0x45, 0x64, 0x76, 0xd6, 0x51,
-	0x82, 0x22, 0x4a, 0x2f, 0x60, 0x9f, 0x83, 0x44, 0xf5, 0x3e, 0x63, 0x95, 0xc6, 0x74, 0x46, 0xd4,
-	0x2c, 0x4a, 0xc9, 0xc1, 0xb0, 0xa7, 0x1b, 0xb3, 0x5e, 0x7b, 0x0a, 0x20, 0x7a, 0x4d, 0x6b, 0x4c,
-	0x93, 0x6b, 0x2e, 0x30, 0x9d, 0xa1, 0x4f, 0x15, 0xa9, 0x8a, 0x9e, 0xff, 0x60, 0xbd, 0xa2, 0x79,
-	0x20, 0xc5, 0xb7, 0x2e, 0x74, 0xdf, 0x65, 0x63, 0xea, 0x25, 0xfe, 0x98, 0xfc, 0x17, 0x72, 0x58,
-	0xc2, 0xce, 0x79, 0x40, 0x3c, 0x46, 0xf3, 0x2c, 0x16, 0xb3, 0x9d, 0x64, 0x89, 0xf8, 0x25, 0x10,
-	0xaf, 0xc9, 0x65, 0x5e, 0x7f, 0x7f, 0xae, 0x7e, 0x3e, 0xf8, 0x99, 0xf1, 0x70, 0x8a, 0x83, 0x60,
-	0x29, 0x4b, 0x2c, 0x04, 0x36, 0x41, 0x89, 0x48, 0x3e, 0xf2, 0xb4, 0xdf, 0x8d, 0x1d, 0xa9, 0x3c,
-	0xe3, 0xcc, 0xf8, 0xb7, 0x01, 0x0f, 0xe5, 0x5b, 0x63, 0x16, 0xff, 0x7b, 0x42, 0x29, 0x9e, 0x92,
-	0x72, 0x2e, 0x2d, 0x16, 0xce, 0x58, 0x2f, 0x9c, 0x0d, 0x2d, 0xca, 0xe2, 0xaf, 0x46, 0x2f, 0x97,
-	0x59, 0x45, 0x38, 0x3e, 0xd4, 0xaa, 0x89, 0x8a, 0x08, 0x49, 0x9b, 0xe2, 0x7a, 0x61, 0x8a, 0x15,
-	0x53, 0x34, 0x56, 0x4c, 0x81, 0x5e, 0xc0, 0xbe, 0x9c, 0x36, 0x0e, 0xc7, 0x88, 0xb7, 0x83, 0x68,
-	0xb0, 0xae, 0x7e, 0x71, 0xc5, 0xe6, 0xe2, 0x1b, 0xe8, 0x10, 0x89, 0xeb, 0xc8, 0x0f, 0xaf, 0x23,
-	0xde, 0x67, 0xed, 0xfe, 0x63, 0xad, 0x80, 0x3a, 0xee, 0xc3, 0x1d, 0xa2, 0x57, 0xa1, 0xaf, 0xca,
-	0x6e, 0x72, 0xaf, 0x9e, 0xe6, 0xa5, 0x23, 0xc6, 0x5b, 0x40, 0x55, 0xfe, 0x1f, 0x03, 0xf6, 0x37,
-	0x2e, 0xb7, 0xce, 0x6c, 0xd9, 0x22, 0xb0, 0x39, 0x95, 0xd5, 0xb2, 0xa9, 0x54, 0x05, 0xaa, 0x15,
-	0x0b, 0xa4, 0x66, 0xa7, 0xae, 0xcd, 0x4e, 0xa1, 0x68, 0x8d, 0x2d, 0x45, 0xcb, 0x59, 0xa2, 0xb9,
-	0xc1, 0x12, 0x1b, 0xf3, 0xdc, 0xda, 0x36, 0xcf, 0xda, 0x74, 0x9a, 0x85, 0xe9, 0xcc, 0x59, 0x02,
-	0x34, 0x96, 0xd0, 0x38, 0xa5, 0x5d, 0xe4, 0x94, 0xb5, 0x45, 0x68, 0x67, 0x63, 0x11, 0x72, 0x16,
-	0x45, 0x88, 0xc5, 0x2f, 0x15, 0x1b, 0x81, 0x28, 0xf6, 0x3d, 0xb5, 0x8a, 0x70, 0x61, 0xeb, 0xb0,
-	0x9c, 0x42, 0x73, 0x2e, 0x9a, 0x9c, 0x23, 0xdb, 0xee, 0x3f, 0x2b, 0x29, 0xac, 0x1c, 0x85, 0xa1,
-	0x32, 0xef, 0xff, 0x56, 0x87, 0xda, 0x1b, 0x1c, 0x46, 0xe8, 0x12, 0x60, 0x45, 0x21, 0xa8, 0xb7,
-	0xce, 0x07, 0x3a, 0x0d, 0xd9, 0x76, 0xc9, 0x6d, 0x1c, 0x2c, 0x9d, 0x07, 0x9f, 0x55, 0xd0, 0x39,
-	0x98, 0x39, 0x89, 0xa0, 0x27, 0xdb, 0xa9, 0x45, 0x44, 0x2a, 0xe7, 0x1d, 0xe7, 0x01, 0xba, 0x04,
-	0x33, 0xa7, 0x9c, 0x42, 0x98, 0x75, 0x22, 0xb2, 0xcb, 0xfa, 0x98, 0xe3, 0xc8, 0x9f, 0xf4, 0x33,
-	0x74, 0xd7, 0xf7, 0x06, 0xe4, 0xdc, 0xb9, 0x54, 0x88, 0xc8, 0x47, 0xf7, 0x2d, 0x1e, 0xce, 0x03,
-	0x74, 0x05, 0xbb, 0xc5, 0x4d, 0x0c, 0x6d, 0xf1, 0x2a, 0xee, 0x75, 0xf6, 0xb3, 0x3b, 0x2c, 0x44,
-	0xd4, 0x1f, 0xa1, 0x53, 0x58, 0x21, 0xd1, 0x87, 0x9b, 0x2e, 0x85, 0x65, 0xd4, 0x7e, 0x5a, 0x6e,
-	0x20, 0x42, 0xfa, 0xf0, 0x68, 0xeb, 0xe6, 0x8b, 0x9e, 0x6b, 0x9e, 0x77, 0x6d, 0xd5, 0xf6, 0x27,
-	0xf7, 0x1b, 0x8a, 0x54, 0x2f, 0xa1, 0xc6, 0x36, 0x78, 0x74, 0xa8, 0x57, 0x66, 0xf5, 0xbf, 0x84,
-	0x7d, 0xb0, 0xa1, 0xe7, 0x7e, 0x83, 0x97, 0xf0, 0xc4, 0x8f, 0xdc, 0x69, 0x12, 0x7b, 0x2e, 0xf9,
-	0x15, 0xcf, 0xe3, 0x80, 0x50, 0x77, 0x46, 0x82, 0x20, 0x5a, 0x44, 0x49, 0x30, 0x19, 0xec, 0x5d,
-	0xb0, 0xf3, 0x7b, 0x76, 0x7e, 0xcb, 0x7c, 0xdf, 0x56, 0xfe, 0x30, 0xaa, 0x17, 0xdf, 0xbd, 0x1f,
-	0x37, 0x78, 0xa8, 0x2f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x27, 0x4a, 0x76, 0x6d, 0xca, 0x0c,
-	0x00, 0x00,
+	// 3214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4b, 0x77, 0xdb, 0xc6,
+	0xd5, 0x06, 0x45, 0x52, 0xe4, 0xe5, 0xd3, 0xa3, 0x87, 0x69, 0xfa, 0x11, 0x1b, 0xce, 0xc3, 0x71,
+	0x3e, 0x2b, 0x89, 0x12, 0xfb, 0xe4, 0xfb, 0x3e, 0xb7, 0x8d, 0x25, 0xd3, 0xb5, 0x12, 0x47, 0x52,
+	0x21, 0xd5, 0x3e, 0xed, 0x06, 0x07, 0x02, 0x46, 0x22, 0x22, 0x10, 0x60, 0x00, 0x50, 0x0a, 0xd3,
+	0xd7, 0x32, 0xab, 0x6c, 0xbb, 0xe8, 0xa2, 0x8b, 0xf4, 0x0f, 0x64, 0xd1, 0x2e, 0xfa, 0x1f, 0xfa,
+	0x07, 0xba, 0x6c, 0xfb, 0x07, 0xba, 0xef, 0xa6, 0x67, 0x9e, 0x98, 0x01, 0x41, 0xc9, 0x3a, 0xcd,
+	0x39, 0x5d, 0x71, 0xee, 0x9d, 0x3b, 0x77, 0xee, 0xdc, 0xd7, 0xdc, 0xb9, 0x20, 0x40, 0xe8, 0x84,
+	0xd1, 0xda, 0x38, 0x8e, 0xd2, 0x08, 0xd5, 0xc9, 0x98, 0x0e, 0xcd, 0xaf, 0x0d, 0x68, 0xec, 0xe1,
+	0xd0, 0xb3, 0xf0, 0x17, 0x13, 0x9c, 0xa4, 0x68, 0x15, 0xaa, 0xa7, 0x4e, 0x10, 0xe0, 0xb4, 0x67,
+	0xdc, 0x32, 0xee, 0xd6, 0x2d, 0x0e, 0x11, 0x7c, 0x12, 0x4d, 0x62, 0x17, 0xf7, 0x4a, 0x0c, 0xcf,
+	0x20, 0x74, 0x0b, 0x1a, 0x1e, 0x4e, 0x52, 0x3f, 0x74, 0x52, 0x3f, 0x0a, 0x7b, 0x0b, 0x74, 0x52,
+	0x45, 0x91, 0x95, 0xce, 0x28, 0x9a, 0x84, 0x69, 0xaf, 0xcc, 0x56, 0x32, 0x08, 0xb5, 0xa1, 0xe4,
+	0x7b, 0xbd, 0x0a, 0xc5, 0x95, 0x7c, 0xcf, 0xbc, 0x0d, 0x75, 0x26, 0xc8, 0x38, 0x98, 0xa2, 0x65,
+	0xa8, 0x1c, 0x04, 0x91, 0x7b, 0xcc, 0xa5, 0x60, 0x80, 0x19, 0x40, 0xdb, 0xc2, 0x2e, 0xf6, 0x4f,
+	0xf0, 0x79, 0xe2, 0xf6, 0x60, 0xd1, 0x71, 0x5d, 0xba, 0x2b, 0x93, 0x57, 0x80, 0x19, 0xe7, 0x05,
+	0x85, 0x33, 0x42, 0x50, 0x3e, 0x8d, 0xe2, 0x63, 0x2e, 0x22, 0x1d, 0x9b, 0xaf, 0x43, 0x53, 0xee,
+	0x36, 0x5f, 0xa6, 0xdf, 0x1b, 0xb0, 0xfa, 0x98, 0xf1, 0x4e, 0x76, 0x71, 0xe8, 0xf9, 0xe1, 0x91,
+	0x10, 0xae, 0x0f, 0x35, 0xbe, 0x6b, 0xd2, 0x33, 0x6e, 0x2d, 0xdc, 0xad, 0x5b, 0x12, 0x26, 0xcc,
+	0x32, 0xf1, 0xca, 0x16, 0x03, 0xd0, 0x75, 0xa8, 0xa7, 0xc3, 0x18, 0x27, 0xc3, 0x28, 0xf0, 0xb8,
+	0x80, 0x19, 0x02, 0x7d, 0x08, 0xab, 0x7e, 0xe8, 0x06, 0x13, 0x0f, 0xdb, 0x51, 0x18, 0x4c, 0x6d,
+	0x37, 0x0a, 0x0f, 0xfd, 0x78, 0x84, 0x3d, 0x2a, 0x76, 0xcd, 0x5a, 0xe6, 0xb3, 0x3b, 0x61, 0x30,
+	0xdd, 0x14, 0x73, 0xa6, 0x05, 0x4d, 0x2e, 0xd7, 0x86, 0x38, 0xea, 0xd0, 0x49, 0x86, 0xfc, 0x14,
+	0x74, 0xac, 0xd8, 0xa8, 0xa4, 0xd9, 0x28, 0xb3, 0xfa, 0x82, 0x6a, 0x75, 0xf3, 0x63, 0x68, 0xa9,
+	0x3c, 0x13, 0xf4, 0x2e, 0x54, 0xa9, 0x3a, 0xd8, 0x41, 0x1b, 0xeb, 0x57, 0xd6, 0xa4, 0x8b, 0xad,
+	0xa9, 0x94, 0x16, 0x27, 0x33, 0xbf, 0x33, 0x60, 0x79, 0x46, 0x6d, 0x44, 0xcb, 0x9b, 0x39, 0x4e,
+	0xef, 0x28, 0x9c, 0x8a, 0x16, 0xac, 0x31, 0x09, 0x06, 0x61, 0x1a, 0x4f, 0x05, 0xf7, 0xfe, 0x1e,
+	0x34, 0x14, 0x34, 0xea, 0xc2, 0xc2, 0x31, 0x9e, 0xf2, 0x13, 0x93, 0x21, 0x5a, 0x83, 0xca, 0x89,
+	0x13, 0x4c, 0x98, 0x37, 0x37, 0xd6, 0x7b, 0x73, 0xc4, 0x4d, 0x2c, 0x46, 0xf6, 0x7f, 0xa5, 0x8f,
+	0x0c, 0xf3, 0x87, 0xd0, 0xb5, 0x9c, 0xd3, 0xc7, 0x2e, 0xf1, 0x6a, 0xc5, 0xff, 0x1c, 0x8a, 0x10,
+	0xfe, 0xc7, 0x20, 0xa2, 0xe4, 0xcf, 0x93, 0x28, 0xe4, 0xea, 0xa4, 0x63, 0xf3, 0x75, 0x68, 0x2b,
+	0xeb, 0xc9, 0x59, 0x05, 0x95, 0xa1, 0x50, 0x75, 0xa0, 0xb5, 0x97, 0x3a, 0xe9, 0x24, 0xe1, 0x5b,
+	0x98, 0xff, 0x30, 0x00, 0xb6, 0x23, 0x0f, 0x33, 0x2c, 0xba, 0x09, 0xe0, 0x46, 0x61, 0x88, 0xd5,
+	0x5d, 0x15, 0x0c, 0xe1, 0x19, 0x47, 0x81, 0x08, 0x53, 0x3a, 0x26, 0xd1, 0x30, 0xc4, 0x4e, 0x90,
+	0x0e, 0xa7, 0xd4, 0x8e, 0x35, 0x4b, 0x80, 0xd4, 0xc0, 0xd3, 0xd0, 0x95, 0x2e, 0xc4, 0x21, 0xf4,
+	0x1a, 0x34, 0xa8, 0x2a, 0x6d, 0xe6, 0xa4, 0x15, 0xea, 0xa4, 0x40, 0x51, 0x9b, 0x04, 0x43, 0x58,
+	0x9e, 0xe0, 0x38, 0x21, 0x32, 0x54, 0x59, 0x80, 0x71, 0x90, 0xcc, 0x1c, 0xc4, 0xd8, 0x39, 0xc6,
+	0x71, 0x6f, 0x91, 0xcd, 0x70, 0x90, 0xc4, 0xc3, 0xa1, 0xe3, 0x07, 0x93, 0x18, 0x27, 0xbd, 0xda,
+	0x2d, 0xe3, 0x6e, 0xcb, 0x92, 0xb0, 0x79, 0x0c, 0x0d, 0x71, 0x6c, 0xa2, 0x99, 0x77, 0xa0, 0x12,
+	0x46, 0x1e, 0x16, 0x4e, 0xb0, 0xa2, 0xd8, 0x27, 0xd3, 0x85, 0xc5, 0x68, 0xd0, 0xbb, 0xb0, 0x74,
+	0x8a, 0x0f, 0x92, 0xc8, 0x3d, 0xc6, 0xa9, 0xcd, 0x55, 0x81, 0x3d, 0xaa, 0x81, 0x9a, 0x85, 0xe4,
+	0xd4, 0xa6, 0x98, 0x31, 0x3f, 0x82, 0xeb, 0x2f, 0x9c, 0xc0, 0xf7, 0x9c, 0x14, 0x73, 0x97, 0xda,
+	0x9e, 0x8c, 0x0e, 0x70, 0x2c, 0xac, 0xaa, 0x64, 0x0f, 0x43, 0xcb, 0x1e, 0xe6, 0x3a, 0xf4, 0xe7,
+	0xac, 0xe4, 0x19, 0xe2, 0x84, 0xcc, 0x8a, 0x0c, 0x41, 0x01, 0x73, 0x4d, 0x7a, 0xfa, 0x66, 0x8c,
+	0x9d, 0xf4, 0xbc, 0xdc, 0x65, 0xae, 0x01, 0xca, 0xd1, 0x13, 0xde, 0xf3, 0x65, 0x7a, 0x1f, 0x56,
+	0x38, 0xfd, 0x86, 0x13, 0x38, 0xa1, 0x8b, 0xcf, 0x3f, 0xc6, 0x16, 0x2c, 0xe5, 0x97, 0xf0, 0x3d,
+	0x0e, 0x18, 0x2c, 0x16, 0x70, 0x90, 0xcc, 0x8c, 0x59, 0x5c, 0x88, 0x7c, 0xca, 0x41, 0xf3, 0x4f,
+	0x86, 0x14, 0x77, 0x2b, 0x3c, 0x8c, 0xce, 0xdd, 0x1b, 0xbd, 0x09, 0xed, 0x18, 0x8f, 0x63, 0x9c,
+	0xe0, 0x30, 0x75, 0x52, 0xff, 0x04, 0x73, 0x43, 0xe5, 0xb0, 0x54, 0x3d, 0xd8, 0x3f, 0x1a, 0xa6,
+	0xdc, 0x67, 0x39, 0xa4, 0x8a, 0xc2, 0x7c, 0x56, 0x80, 0xe8, 0x1d, 0xb8, 0x2c, 0xf2, 0x63, 0x96,
+	0x1a, 0x2b, 0x94, 0xa6, 0xcb, 0x27, 0xb2, 0xb4, 0xf8, 0x6d, 0x15, 0xba, 0x9a, 0xdc, 0x44, 0x01,
+	0xc4, 0x43, 0xe3, 0x28, 0x4c, 0x7d, 0x1c, 0x73, 0xb1, 0x25, 0x8c, 0x6e, 0x00, 0x44, 0x63, 0x1c,
+	0xda, 0xec, 0x0e, 0x60, 0x5a, 0xa8, 0x13, 0x0c, 0x4b, 0xab, 0xef, 0xc3, 0xb2, 0x7e, 0x00, 0x5b,
+	0xbd, 0x66, 0x96, 0xf4, 0x39, 0xb6, 0x44, 0x51, 0x77, 0x59, 0x57, 0xf7, 0x7d, 0x40, 0xa3, 0xc8,
+	0xf3, 0x0f, 0x7d, 0xec, 0xd9, 0xa9, 0x3f, 0xc2, 0x49, 0xea, 0x8c, 0xc6, 0xfc, 0xae, 0xbc, 0x2c,
+	0x66, 0xf6, 0xc5, 0x44, 0x3e, 0x5a, 0x59, 0x40, 0xaa, 0xd1, 0xfa, 0x16, 0x74, 0xb8, 0xfa, 0x6d,
+	0x11, 0xb5, 0x2c, 0x36, 0xdb, 0x1c, 0xfd, 0x82, 0x61, 0x49, 0x28, 0x71, 0xd5, 0xd1, 0xcb, 0xdb,
+	0x1e, 0x32, 0x0b, 0xd4, 0x28, 0x31, 0x52, 0xa7, 0x9e, 0x31, 0x6b, 0x7c, 0x0c, 0xd7, 0x0b, 0x16,
+	0xd8, 0x52, 0x8b, 0x75, 0xba, 0xb2, 0x3f, 0xbb, 0xf2, 0xa9, 0xd0, 0xeb, 0xac, 0x3f, 0x00, 0x13,
+	0x6d, 0xae, 0x3f, 0x34, 0x78, 0xb8, 0xcc, 0xf8, 0x43, 0x53, 0x73, 0x4d, 0x92, 0x2a, 0x63, 0x7a,
+	0x81, 0x3b, 0x07, 0x01, 0xee, 0xb5, 0x98, 0x56, 0x32, 0x0c, 0xf1, 0x17, 0xe9, 0x27, 0xb6, 0xb0,
+	0x44, 0x9b, 0x92, 0x75, 0xe5, 0x04, 0x0f, 0x10, 0xf4, 0x36, 0x64, 0x38, 0xa1, 0x96, 0x0e, 0xa5,
+	0xed, 0x48, 0x3c, 0xd7, 0xc9, 0x7d, 0x40, 0x19, 0xa9, 0xd4, 0x44, 0x97, 0x59, 0x4f, 0xce, 0x48,
+	0x05, 0xfc, 0x2f, 0xf4, 0x32, 0xf2, 0x9c, 0x2a, 0x2e, 0xd3, 0x45, 0x57, 0xe4, 0xbc, 0xa5, 0xeb,
+	0x44, 0x3b, 0x81, 0xd0, 0x02, 0xca, 0x9d, 0x80, 0x5f, 0x68, 0xc4, 0x43, 0xd5, 0x7d, 0xa4, 0x62,
+	0x96, 0x98, 0x87, 0x2a, 0x7b, 0x88, 0x29, 0xf3, 0xeb, 0x92, 0xcc, 0x2d, 0xcf, 0xfc, 0x24, 0x8d,
+	0xe2, 0xe9, 0xf9, 0xf1, 0x5d, 0x5c, 0xd9, 0x5c, 0x83, 0xfa, 0xd8, 0x39, 0xc2, 0x76, 0xe2, 0x7f,
+	0xc5, 0x8a, 0x89, 0x96, 0x55, 0x23, 0x88, 0x3d, 0xff, 0x2b, 0x4c, 0x4b, 0x12, 0xec, 0x78, 0xa2,
+	0xfa, 0x22, 0x63, 0x62, 0xee, 0xe8, 0xf0, 0x30, 0xc1, 0xe2, 0xf2, 0xe1, 0x10, 0xd9, 0x38, 0xc6,
+	0xc4, 0x89, 0x31, 0xf5, 0xf3, 0x9a, 0x25, 0x40, 0x72, 0xcb, 0xc7, 0xce, 0x29, 0x75, 0xec, 0x9a,
+	0x45, 0x86, 0xe8, 0x0d, 0x10, 0xfe, 0x6d, 0x1f, 0xfa, 0x41, 0x8a, 0xe3, 0x5e, 0x8d, 0x96, 0x61,
+	0x2d, 0x8e, 0x7d, 0x4a, 0x91, 0xc8, 0x84, 0xa6, 0x4b, 0x6c, 0x11, 0x4e, 0x58, 0x11, 0xcb, 0x7c,
+	0x56, 0xc3, 0x99, 0xdf, 0x2d, 0xc0, 0x92, 0xae, 0x09, 0x56, 0x5a, 0x20, 0x28, 0xa7, 0xd3, 0xb1,
+	0xc8, 0x97, 0x74, 0x7c, 0x46, 0xf1, 0x99, 0xd5, 0x59, 0x0b, 0x5a, 0x9d, 0xf5, 0x16, 0x74, 0x82,
+	0xc8, 0x75, 0x02, 0x25, 0xd8, 0x99, 0x2e, 0xda, 0x14, 0x9d, 0x45, 0xfa, 0x2a, 0x54, 0xb9, 0xef,
+	0xb1, 0x64, 0xc0, 0x21, 0x59, 0xd4, 0x55, 0x95, 0xa2, 0xee, 0x3a, 0xd4, 0xb3, 0x34, 0xc8, 0xc2,
+	0x3d, 0x43, 0x14, 0x84, 0x5d, 0xad, 0x30, 0xec, 0x10, 0x94, 0x03, 0x3f, 0x3c, 0xe6, 0x4a, 0xa1,
+	0x63, 0x35, 0x71, 0x81, 0x9e, 0xb8, 0xfa, 0x50, 0x1b, 0xc7, 0xf8, 0xc4, 0x8f, 0x26, 0x09, 0x0f,
+	0x53, 0x09, 0x93, 0x55, 0xc9, 0xe4, 0x80, 0x6a, 0x8b, 0x07, 0x2a, 0x07, 0x89, 0xa4, 0x89, 0x7f,
+	0x14, 0x3a, 0xe9, 0x24, 0x16, 0x71, 0x9a, 0x21, 0x64, 0x6d, 0xde, 0xce, 0x6a, 0xf3, 0x19, 0x93,
+	0x75, 0x0a, 0x4c, 0xf6, 0x00, 0xae, 0x88, 0x82, 0x91, 0x07, 0x71, 0xf2, 0x0a, 0x95, 0xb9, 0xf9,
+	0x03, 0x58, 0xdc, 0xc8, 0x6e, 0xbd, 0x0b, 0xdf, 0x87, 0x7f, 0x34, 0x60, 0x65, 0x76, 0x5b, 0x72,
+	0xb9, 0x7c, 0x02, 0x35, 0xbe, 0x5c, 0x94, 0x35, 0x6b, 0x05, 0xb5, 0xad, 0xb6, 0x66, 0x4d, 0x40,
+	0xac, 0xbc, 0x95, 0xeb, 0xfb, 0x3b, 0xd0, 0xd2, 0xa6, 0x0a, 0x4a, 0xdc, 0xbb, 0x7a, 0x89, 0x8b,
+	0x94, 0xbd, 0xf8, 0x52, 0xb5, 0xb8, 0x7d, 0x13, 0xba, 0xf4, 0x52, 0x52, 0xef, 0xf0, 0x82, 0x97,
+	0x82, 0xf9, 0xdb, 0x12, 0xb4, 0x15, 0x42, 0x72, 0xae, 0x3b, 0xd0, 0x62, 0xb7, 0x8f, 0x9e, 0x10,
+	0x9a, 0x14, 0xf9, 0x78, 0xc6, 0xf3, 0xf5, 0x17, 0x86, 0xa2, 0xe2, 0x05, 0x5d, 0xc5, 0x99, 0xab,
+	0x97, 0x35, 0x57, 0x2f, 0x88, 0x95, 0x4a, 0x61, 0xac, 0x68, 0xfe, 0x5f, 0xcd, 0xfb, 0xff, 0x87,
+	0x50, 0x23, 0xde, 0x82, 0x89, 0x0b, 0x2c, 0xce, 0x3c, 0x02, 0x36, 0xd8, 0xdd, 0xc9, 0xe6, 0x2d,
+	0x49, 0xa9, 0xfa, 0x70, 0x4d, 0xf3, 0x61, 0xf3, 0x0f, 0x25, 0x68, 0x69, 0xab, 0x2e, 0x98, 0x1a,
+	0xd4, 0xc8, 0x59, 0xc8, 0x45, 0xce, 0x6c, 0xac, 0x96, 0x0b, 0x63, 0x55, 0x51, 0x66, 0x45, 0x57,
+	0xa6, 0x88, 0xe2, 0xaa, 0x12, 0xc5, 0x6f, 0x42, 0x87, 0xfc, 0xda, 0x4e, 0x22, 0x2d, 0xc7, 0xb2,
+	0x44, 0x8b, 0xa0, 0x1f, 0x27, 0xc2, 0x74, 0x5a, 0x74, 0xd6, 0xe6, 0x45, 0x67, 0x5d, 0x89, 0x4e,
+	0x45, 0x4b, 0xa0, 0x6b, 0xe9, 0x25, 0x5c, 0x66, 0x0f, 0x2b, 0xd5, 0xcf, 0x88, 0xa5, 0x9d, 0x64,
+	0x88, 0x45, 0x2c, 0x72, 0x08, 0xdd, 0xcb, 0xea, 0xb9, 0x30, 0x4a, 0xed, 0xc3, 0x68, 0x12, 0x8a,
+	0xaa, 0xbe, 0xc3, 0x27, 0xb6, 0xa3, 0xf4, 0x29, 0x41, 0x9b, 0xbf, 0x86, 0x8e, 0xca, 0x98, 0xf8,
+	0xe5, 0x0d, 0x60, 0x25, 0x90, 0xad, 0x38, 0x71, 0x9d, 0x62, 0x9e, 0x91, 0xf4, 0xf8, 0xae, 0x78,
+	0xce, 0xb3, 0xf8, 0xb8, 0x9a, 0xb7, 0xbe, 0x64, 0x24, 0x7a, 0x04, 0xd7, 0xa0, 0x9e, 0x89, 0xc1,
+	0x6a, 0xd2, 0x5a, 0x28, 0xf6, 0xff, 0xa7, 0x01, 0xdd, 0xbd, 0xc9, 0x41, 0xe2, 0xc6, 0xfe, 0x01,
+	0x7e, 0x95, 0x06, 0xc0, 0x7d, 0xa8, 0x8c, 0x9c, 0xd4, 0x1d, 0xd2, 0xed, 0xdb, 0xda, 0x83, 0x99,
+	0x2b, 0xfe, 0x33, 0x32, 0x6d, 0x31, 0x2a, 0xc2, 0x8a, 0xeb, 0x90, 0xb8, 0x07, 0x65, 0x25, 0x60,
+	0x72, 0xd0, 0x91, 0x1f, 0xda, 0x5a, 0x97, 0xa5, 0x3e, 0xf2, 0xc3, 0xc7, 0x14, 0x81, 0x1e, 0x40,
+	0x2d, 0x3a, 0xc1, 0xf1, 0x61, 0x10, 0x9d, 0x52, 0xb7, 0x68, 0x6b, 0x67, 0xdd, 0xe1, 0x53, 0xbb,
+	0x51, 0xe0, 0xbb, 0x53, 0x4b, 0x92, 0x92, 0xa2, 0x32, 0xc6, 0xc9, 0x64, 0x84, 0x49, 0x09, 0x33,
+	0xa2, 0x9e, 0x53, 0xb6, 0x80, 0xa1, 0x9e, 0xc6, 0xd1, 0xc8, 0x9c, 0x42, 0x73, 0x10, 0xb0, 0x57,
+	0x27, 0xd1, 0x15, 0x11, 0xd1, 0x9b, 0xc4, 0x8e, 0xf2, 0x2e, 0x95, 0x30, 0x8d, 0x05, 0x7f, 0x24,
+	0x5f, 0xa5, 0x64, 0x4c, 0x0a, 0x85, 0xd4, 0x09, 0x82, 0xa9, 0xe8, 0xc4, 0x50, 0x80, 0x64, 0x93,
+	0x98, 0xa9, 0xcf, 0x76, 0x95, 0xf3, 0x34, 0x39, 0x92, 0xd6, 0xb3, 0xe6, 0x5f, 0x4a, 0xb0, 0xc4,
+	0xb5, 0x3d, 0x26, 0xfc, 0x3f, 0xc3, 0x49, 0xe2, 0x1c, 0xe1, 0x33, 0xaa, 0x12, 0xcd, 0x89, 0x4b,
+	0x79, 0x27, 0x26, 0xda, 0x25, 0xfc, 0xb3, 0x34, 0x24, 0x61, 0xe2, 0x9d, 0xbc, 0x21, 0x51, 0x66,
+	0xde, 0xc9, 0x20, 0x25, 0xa3, 0x55, 0xb4, 0x8c, 0x56, 0x74, 0x15, 0x67, 0x75, 0x1a, 0xab, 0x92,
+	0x69, 0x68, 0x2c, 0x6a, 0x75, 0x1a, 0x9d, 0xd8, 0x27, 0x39, 0xe2, 0x11, 0xb4, 0x30, 0xd7, 0xab,
+	0xed, 0x87, 0x87, 0x11, 0x8d, 0x39, 0xbd, 0xa5, 0xa2, 0xea, 0xdd, 0x6a, 0x62, 0x05, 0x42, 0xeb,
+	0xc2, 0xad, 0xeb, 0x74, 0xd5, 0x75, 0x65, 0x95, 0xaa, 0x31, 0xd6, 0x8d, 0x61, 0xa4, 0xe6, 0xdf,
+	0x4a, 0x70, 0x79, 0x66, 0xb2, 0x30, 0x7f, 0xcd, 0x6b, 0x03, 0xce, 0x66, 0xa8, 0x85, 0x79, 0x19,
+	0xca, 0x71, 0x55, 0xbb, 0x0a, 0x50, 0xe6, 0x91, 0x8a, 0x92, 0x47, 0x34, 0xa3, 0x55, 0x0b, 0x8c,
+	0x26, 0x33, 0xe6, 0xe2, 0x4c, 0xc6, 0x9c, 0xc9, 0x6d, 0xb5, 0xa2, 0xdc, 0xa6, 0x64, 0xaa, 0xba,
+	0x5e, 0x93, 0x88, 0x8c, 0x09, 0xc5, 0x75, 0x4f, 0x43, 0xcf, 0xaf, 0xb9, 0x36, 0x68, 0x73, 0xa6,
+	0x0d, 0x6a, 0xfe, 0xdd, 0xd0, 0x75, 0xcc, 0xae, 0x6d, 0x12, 0x03, 0xd1, 0xd8, 0x77, 0x45, 0xc7,
+	0x80, 0x02, 0x85, 0xd1, 0xf2, 0x11, 0x2c, 0x8e, 0x98, 0x97, 0x53, 0xd5, 0x36, 0xd6, 0x6f, 0xce,
+	0xb1, 0x2c, 0x8f, 0x05, 0x4b, 0x90, 0xa3, 0x0f, 0xa0, 0x9a, 0xd0, 0x7e, 0x09, 0x55, 0x79, 0x7b,
+	0xfd, 0x9a, 0xb2, 0x70, 0x53, 0x36, 0x8e, 0x78, 0x4b, 0x85, 0x93, 0x92, 0xa3, 0x7a, 0x71, 0x34,
+	0x1e, 0xf3, 0x17, 0x74, 0xd9, 0x12, 0xa0, 0x16, 0x2b, 0x2c, 0x29, 0x48, 0xd8, 0x1c, 0x40, 0x73,
+	0x9f, 0x9c, 0x40, 0x24, 0x40, 0x35, 0xf5, 0x18, 0xaf, 0x9c, 0x7a, 0xcc, 0x2f, 0x61, 0x45, 0xe6,
+	0xd2, 0x17, 0x51, 0x9a, 0xd5, 0x6d, 0x77, 0xa1, 0xa3, 0x3b, 0x94, 0xc8, 0xab, 0x79, 0xb4, 0xb6,
+	0x73, 0xe9, 0xd5, 0x77, 0xfe, 0xc6, 0x80, 0x06, 0xd9, 0xf1, 0xbf, 0x91, 0x50, 0x44, 0xbc, 0x55,
+	0xb2, 0x78, 0x33, 0x8f, 0xa0, 0x4e, 0xc4, 0xb9, 0xa8, 0xb3, 0xbc, 0x97, 0x77, 0x96, 0x55, 0xe5,
+	0xf0, 0xca, 0xf9, 0xa4, 0x93, 0x98, 0xff, 0x03, 0xab, 0x7b, 0x29, 0x35, 0xb0, 0xc8, 0x2d, 0x42,
+	0x05, 0x45, 0x55, 0xe0, 0xaf, 0x60, 0x39, 0x47, 0x7d, 0x51, 0x09, 0xff, 0x3f, 0x2f, 0xe1, 0x6d,
+	0xd5, 0x9d, 0x0b, 0x25, 0xc9, 0x84, 0xfd, 0x97, 0x41, 0x4a, 0x7b, 0x62, 0xe8, 0x27, 0xfe, 0xe1,
+	0xa1, 0xef, 0x4e, 0x82, 0x74, 0x2a, 0xc4, 0xbd, 0x09, 0x30, 0x9a, 0x04, 0xa9, 0x3f, 0x0e, 0xb2,
+	0x26, 0x8e, 0x82, 0x21, 0xe5, 0x63, 0x88, 0x53, 0x92, 0x5e, 0x6c, 0x77, 0x12, 0xc7, 0x58, 0x56,
+	0x62, 0x6d, 0x8e, 0xde, 0x64, 0x58, 0x95, 0x70, 0xe4, 0x87, 0xfe, 0x68, 0x32, 0x12, 0x39, 0x8d,
+	0xa3, 0x3f, 0x63, 0x58, 0xf4, 0x10, 0xae, 0x08, 0x42, 0xf6, 0xaa, 0xc6, 0x92, 0x33, 0xcb, 0x71,
+	0x2b, 0x7c, 0x9a, 0x7f, 0x4d, 0x10, 0x1b, 0x14, 0xac, 0x13, 0x1b, 0x55, 0x8a, 0xd6, 0xf1, 0xfd,
+	0xcc, 0xdf, 0xc0, 0x4a, 0xfe, 0xf0, 0x17, 0xd5, 0xfe, 0xa3, 0xbc, 0xf6, 0x4d, 0xad, 0xfc, 0x28,
+	0xd4, 0x6c, 0xa6, 0xfe, 0x5f, 0x40, 0xe3, 0x25, 0x15, 0x4b, 0xb6, 0x02, 0x44, 0x53, 0xc9, 0xd0,
+	0x5b, 0xc1, 0xc2, 0x75, 0x4a, 0xca, 0x55, 0x78, 0x13, 0xc0, 0x93, 0xac, 0xb9, 0x46, 0x15, 0x4c,
+	0xce, 0x7e, 0xe5, 0xbc, 0xfd, 0xcc, 0x2f, 0x01, 0xd8, 0xe6, 0xc9, 0x24, 0x50, 0x3f, 0x50, 0x18,
+	0xda, 0x7d, 0x24, 0x6e, 0x93, 0x92, 0x72, 0x9b, 0xfc, 0xa7, 0x3b, 0xff, 0xd5, 0x60, 0xe7, 0x56,
+	0x72, 0x43, 0x32, 0x71, 0x5d, 0x9c, 0x24, 0xe2, 0xdc, 0x1c, 0x24, 0x52, 0xc5, 0xd8, 0xc9, 0xfa,
+	0xff, 0x1c, 0xd2, 0x2a, 0xa4, 0x85, 0x5c, 0x85, 0xf4, 0x1e, 0xe9, 0x6b, 0x50, 0x85, 0xf6, 0xca,
+	0x33, 0x21, 0xab, 0xa8, 0xdb, 0x12, 0x64, 0xe8, 0x3e, 0xd9, 0x85, 0x68, 0x81, 0xba, 0x8b, 0xde,
+	0x24, 0xcf, 0x54, 0x64, 0x71, 0x22, 0x52, 0xbe, 0x1e, 0x38, 0xa4, 0x4b, 0x84, 0xe3, 0xa4, 0x57,
+	0x65, 0x25, 0xe4, 0x81, 0xe3, 0xed, 0x12, 0x98, 0xe4, 0x19, 0xb2, 0xe4, 0x7b, 0xcd, 0x33, 0x8a,
+	0xae, 0x32, 0xdf, 0xf9, 0x73, 0x05, 0xba, 0xfb, 0x38, 0xc0, 0x23, 0x9c, 0xc6, 0x32, 0x66, 0x73,
+	0xfd, 0x4b, 0x63, 0xa6, 0x7f, 0xf9, 0x06, 0xb4, 0x5d, 0x3c, 0xc2, 0x61, 0x8a, 0x3d, 0x5b, 0x7d,
+	0x3d, 0xb5, 0x04, 0x56, 0xb6, 0x39, 0x27, 0xa1, 0x3b, 0xc4, 0xee, 0xb1, 0xa4, 0xe3, 0x21, 0x2b,
+	0xd1, 0x8c, 0xf0, 0x0e, 0x88, 0x16, 0x90, 0x5e, 0x64, 0x72, 0xa4, 0x24, 0x3a, 0x70, 0x42, 0xef,
+	0xd4, 0xf7, 0xd2, 0xa1, 0xed, 0x3a, 0xe2, 0x99, 0xd9, 0x94, 0xc8, 0x4d, 0x67, 0x4c, 0x6a, 0x6f,
+	0xa2, 0x51, 0xad, 0xf3, 0x5a, 0x27, 0x18, 0xc6, 0xe3, 0x6d, 0xe8, 0x52, 0x65, 0xb8, 0x51, 0x90,
+	0xeb, 0xbc, 0x76, 0x04, 0x5e, 0xb4, 0x5e, 0x57, 0xa1, 0x3a, 0x19, 0x53, 0x0d, 0xb3, 0x4a, 0x85,
+	0x43, 0x44, 0x8c, 0x23, 0x1c, 0xe2, 0xc4, 0x4f, 0xec, 0xac, 0xb0, 0xab, 0x5b, 0x4d, 0x8e, 0x64,
+	0xb5, 0xda, 0x1d, 0x68, 0x8d, 0x9c, 0xcf, 0xa3, 0x58, 0x6e, 0xc2, 0xca, 0x96, 0x26, 0x45, 0x8a,
+	0x1d, 0x08, 0x91, 0x1f, 0x2a, 0x44, 0x0d, 0x4e, 0xe4, 0x87, 0x1a, 0xd1, 0x98, 0xbc, 0x38, 0x24,
+	0x11, 0xab, 0x65, 0x9a, 0x14, 0x29, 0x88, 0xd6, 0x60, 0x69, 0x1c, 0x63, 0x3b, 0xc6, 0x01, 0x76,
+	0x12, 0x2c, 0x49, 0x59, 0xeb, 0xe6, 0xf2, 0x38, 0xc6, 0x16, 0x9b, 0x11, 0xf4, 0xcb, 0xe4, 0xb1,
+	0x43, 0xbe, 0x08, 0xb1, 0x1e, 0x0e, 0x03, 0xe8, 0xd7, 0x4e, 0xf9, 0x86, 0x67, 0x1d, 0x9c, 0x0c,
+	0x41, 0x6a, 0x66, 0x87, 0x66, 0x22, 0x5b, 0x89, 0x5a, 0xd6, 0x44, 0xed, 0x3a, 0xb9, 0x14, 0x85,
+	0xae, 0xc0, 0x62, 0x18, 0x79, 0xd8, 0xf6, 0x3d, 0xde, 0x32, 0xad, 0x12, 0x70, 0xcb, 0xd3, 0xaf,
+	0x69, 0x94, 0xbf, 0xa6, 0xc9, 0xf5, 0xee, 0x79, 0x31, 0x09, 0xe1, 0x25, 0x7e, 0xbd, 0x33, 0x90,
+	0x78, 0xfb, 0x38, 0x8a, 0xd3, 0xde, 0x32, 0xf3, 0x76, 0x32, 0x36, 0xbf, 0x80, 0xb6, 0x74, 0xdd,
+	0x8b, 0x46, 0xca, 0x83, 0x7c, 0xa4, 0xa8, 0x55, 0x58, 0x3e, 0x20, 0xb2, 0x70, 0xf9, 0x25, 0xf4,
+	0xb6, 0xf1, 0xe9, 0x4f, 0xc3, 0xac, 0x1d, 0x4d, 0xcc, 0x7d, 0xd1, 0xcd, 0x1f, 0xe6, 0x37, 0x3f,
+	0xfb, 0x55, 0x20, 0x77, 0xff, 0xc6, 0x80, 0xee, 0x46, 0x14, 0xa5, 0x49, 0x1a, 0x3b, 0x63, 0x11,
+	0xac, 0x59, 0x72, 0x33, 0xb4, 0xe4, 0xc6, 0xbe, 0xe7, 0x97, 0xc4, 0xf7, 0x7c, 0x22, 0xc8, 0x28,
+	0xf2, 0x44, 0xf9, 0x43, 0xc7, 0xe8, 0x36, 0x34, 0xd3, 0x28, 0x75, 0x02, 0x5b, 0x16, 0x40, 0xb4,
+	0x4e, 0xa6, 0x38, 0xfe, 0x25, 0x59, 0xcd, 0x91, 0x15, 0x3d, 0x47, 0x12, 0x03, 0x48, 0x71, 0xbe,
+	0x57, 0x03, 0xe4, 0x0f, 0x29, 0x55, 0x70, 0xef, 0x01, 0x34, 0xd5, 0xe7, 0x38, 0x6a, 0x41, 0xdd,
+	0x1a, 0x6c, 0x6e, 0xed, 0x6e, 0x0d, 0xb6, 0xf7, 0xbb, 0x97, 0x10, 0x40, 0x75, 0x6f, 0xb0, 0xfd,
+	0x64, 0x60, 0x75, 0x0d, 0x32, 0x1e, 0x6c, 0xed, 0x3f, 0x1b, 0x58, 0xdd, 0xd2, 0xbd, 0x47, 0xd0,
+	0xd6, 0x6b, 0x4c, 0xd4, 0x06, 0x78, 0xb2, 0xb5, 0xb7, 0xb9, 0xb3, 0xbd, 0x3d, 0xd8, 0x24, 0x2b,
+	0xeb, 0x50, 0xd9, 0x78, 0xbe, 0xb3, 0xf9, 0x69, 0xd7, 0x40, 0x1d, 0x68, 0x3c, 0xb1, 0x76, 0x76,
+	0xed, 0x9d, 0xe7, 0x4f, 0x06, 0x7b, 0xfb, 0xdd, 0xd2, 0xbd, 0x0f, 0xa0, 0x9b, 0x2f, 0xcc, 0xc9,
+	0xc6, 0x7c, 0xf1, 0xe0, 0x49, 0xf7, 0x12, 0xea, 0x42, 0xd3, 0x1a, 0x70, 0xc4, 0xd6, 0xf6, 0x8f,
+	0xbb, 0xc6, 0xfa, 0xef, 0x5a, 0x50, 0xde, 0x76, 0xc2, 0x08, 0x7d, 0x02, 0x90, 0xb5, 0x42, 0xd0,
+	0xf5, 0x7c, 0x5f, 0x43, 0x6d, 0xbd, 0xf4, 0xfb, 0x73, 0x66, 0xc7, 0xc1, 0xd4, 0xbc, 0xf4, 0x9e,
+	0x81, 0x06, 0x50, 0x97, 0xcd, 0x10, 0x74, 0xad, 0xb8, 0x45, 0xc2, 0x38, 0xcd, 0xef, 0x9f, 0x98,
+	0x97, 0xd0, 0x27, 0x50, 0x97, 0x05, 0xbd, 0xc6, 0x26, 0xdf, 0x32, 0xe9, 0xcf, 0xf3, 0x4c, 0x6a,
+	0x6e, 0x2a, 0xd2, 0xcf, 0xa1, 0x9b, 0xef, 0x95, 0x22, 0xf3, 0xcc, 0x46, 0x2a, 0xe3, 0x7c, 0xeb,
+	0xbc, 0x66, 0xab, 0x79, 0x09, 0xed, 0x43, 0x5b, 0xff, 0x2e, 0x8a, 0x0a, 0x56, 0xe9, 0x5f, 0x59,
+	0xfb, 0x37, 0xcf, 0xa0, 0x60, 0x5c, 0x3f, 0x85, 0x86, 0xf2, 0xa5, 0x11, 0xdd, 0x98, 0x5d, 0xa0,
+	0x2a, 0xf2, 0xda, 0xbc, 0x69, 0xc6, 0xec, 0x05, 0xb4, 0xf5, 0xef, 0x10, 0x45, 0x22, 0xea, 0x1f,
+	0x6b, 0xfa, 0x37, 0xe7, 0x52, 0x64, 0x6a, 0xfd, 0x09, 0xb4, 0xb4, 0xaf, 0xce, 0xe8, 0xb5, 0xd9,
+	0x45, 0xda, 0xf7, 0xeb, 0xfe, 0x8d, 0xf9, 0x04, 0x4c, 0x54, 0x1f, 0x56, 0x0a, 0x3f, 0x96, 0xa3,
+	0xb7, 0xd4, 0xd7, 0xc8, 0x19, 0x1f, 0xe2, 0xfb, 0x6f, 0x9c, 0x4f, 0xc8, 0xb6, 0x7a, 0x08, 0x65,
+	0xf2, 0xe7, 0x21, 0xa4, 0xd6, 0x1f, 0xca, 0xdf, 0x9a, 0xfa, 0xcb, 0x33, 0x78, 0xb6, 0xee, 0x25,
+	0x74, 0x72, 0x7f, 0x2a, 0x41, 0xb7, 0xcf, 0xfa, 0xc3, 0x09, 0xe3, 0xf6, 0xda, 0x39, 0xff, 0x49,
+	0x31, 0x2f, 0xa1, 0x1f, 0xc1, 0x22, 0x2f, 0xdb, 0x91, 0x1a, 0x19, 0xfa, 0xdf, 0x97, 0xfa, 0x57,
+	0x8a, 0xa6, 0x18, 0x83, 0xa7, 0x00, 0x96, 0x73, 0xca, 0x09, 0xb5, 0x98, 0xc9, 0xff, 0x09, 0xa5,
+	0x7f, 0xb5, 0x78, 0x92, 0xf1, 0x79, 0x04, 0x55, 0x9e, 0x41, 0x7a, 0xda, 0x0b, 0x4b, 0xf9, 0x8b,
+	0x49, 0x7f, 0xb5, 0x60, 0x86, 0xad, 0x7e, 0x0e, 0x6d, 0xfd, 0x25, 0xae, 0x79, 0x5b, 0xe1, 0x23,
+	0x5d, 0xd3, 0xb5, 0x7c, 0xbc, 0x52, 0x1f, 0xfb, 0x19, 0x5c, 0x95, 0x4b, 0x72, 0x6f, 0xbc, 0x04,
+	0xa9, 0xba, 0x50, 0x9b, 0x08, 0x9a, 0xb6, 0x8b, 0x5e, 0x9d, 0x3c, 0x2b, 0x64, 0xac, 0x1f, 0xcf,
+	0x54, 0x07, 0xf3, 0x58, 0xdf, 0x3a, 0xe3, 0xd9, 0x93, 0xf1, 0xde, 0x80, 0x96, 0xe4, 0x4d, 0x8a,
+	0xda, 0xf9, 0xfc, 0x96, 0x73, 0xe5, 0x6f, 0xc6, 0xe3, 0x39, 0x20, 0xc9, 0x43, 0x5e, 0xf7, 0xf3,
+	0x19, 0x5d, 0x2d, 0xaa, 0x0e, 0x32, 0x6e, 0x0e, 0xdc, 0x90, 0xdc, 0x0a, 0xea, 0x83, 0x33, 0x94,
+	0x79, 0x47, 0x99, 0x98, 0x57, 0x59, 0xcc, 0x08, 0x2c, 0xaf, 0xc7, 0x57, 0x13, 0x58, 0xbf, 0xa3,
+	0x09, 0xb7, 0x8d, 0x87, 0x70, 0xcd, 0x8f, 0xd6, 0x8e, 0xe2, 0xb1, 0xbb, 0x86, 0xbf, 0x74, 0x46,
+	0xe3, 0x00, 0x27, 0x6b, 0x43, 0x1c, 0x04, 0xd1, 0x69, 0x14, 0x07, 0xde, 0x46, 0xe7, 0x19, 0x19,
+	0xbf, 0x24, 0xe3, 0x5d, 0xc2, 0x62, 0xd7, 0xf8, 0xb6, 0xb4, 0xf0, 0xec, 0xf9, 0xcb, 0x83, 0x2a,
+	0xe5, 0xf8, 0xc1, 0xbf, 0x07, 0x00, 0x5e, 0x11, 0xcd, 0xe2, 0xbe, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1336,9 +3913,22 @@ type NanoClient interface {
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Nano_SubscribeClient, error)
 	AccountsBalances(ctx context.Context, in *AccountsBalancesRequest, opts ...grpc.CallOption) (*AccountsBalancesReply, error)
 	AccountBalance(ctx context.Context, in *AccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalanceReply, error)
+	AccountInfo(ctx context.Context, in *AccountInfoRequest, opts ...grpc.CallOption) (*AccountInfoReply, error)
+	AccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (Nano_AccountHistoryClient, error)
 	AccountCreate(ctx context.Context, in *AccountCreateRequest, opts ...grpc.CallOption) (*AccountCreateReply, error)
 	ValidateAccountNumber(ctx context.Context, in *ValidateAccountNumberRequest, opts ...grpc.CallOption) (*ValidateAccountNumberReply, error)
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error)
+	AccountsPending(ctx context.Context, in *AccountsPendingRequest, opts ...grpc.CallOption) (*AccountsPendingReply, error)
+	Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*ReceiveReply, error)
+	RawRequest(ctx context.Context, in *RawActionRequest, opts ...grpc.CallOption) (*RawActionReply, error)
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusReply, error)
+	SubscribeVotes(ctx context.Context, in *SubscribeVotesRequest, opts ...grpc.CallOption) (Nano_SubscribeVotesClient, error)
+	SubscribeStoppedElections(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeStoppedElectionsClient, error)
+	SubscribeActiveDifficulty(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeActiveDifficultyClient, error)
+	SubscribeWork(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeWorkClient, error)
+	SubscribeTelemetry(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeTelemetryClient, error)
+	SubscribeNewUnconfirmedBlocks(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeNewUnconfirmedBlocksClient, error)
+	SubscribeBootstrap(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeBootstrapClient, error)
 }
 
 type nanoClient struct {
@@ -1440,31 +4030,332 @@ func (c *nanoClient) AccountBalance(ctx context.Context, in *AccountBalanceReque
 	return out, nil
 }
 
-func (c *nanoClient) AccountCreate(ctx context.Context, in *AccountCreateRequest, opts ...grpc.CallOption) (*AccountCreateReply, error) {
-	out := new(AccountCreateReply)
-	err := c.cc.Invoke(ctx, "/nanoproto.Nano/AccountCreate", in, out, opts...)
-	if err != nil {
+func (c *nanoClient) AccountInfo(ctx context.Context, in *AccountInfoRequest, opts ...grpc.CallOption) (*AccountInfoReply, error) {
+	out := new(AccountInfoReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/AccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) AccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (Nano_AccountHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[2], "/nanoproto.Nano/AccountHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoAccountHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_AccountHistoryClient interface {
+	Recv() (*AccountHistoryEntry, error)
+	grpc.ClientStream
+}
+
+type nanoAccountHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoAccountHistoryClient) Recv() (*AccountHistoryEntry, error) {
+	m := new(AccountHistoryEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) AccountCreate(ctx context.Context, in *AccountCreateRequest, opts ...grpc.CallOption) (*AccountCreateReply, error) {
+	out := new(AccountCreateReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/AccountCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) ValidateAccountNumber(ctx context.Context, in *ValidateAccountNumberRequest, opts ...grpc.CallOption) (*ValidateAccountNumberReply, error) {
+	out := new(ValidateAccountNumberReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/ValidateAccountNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error) {
+	out := new(SendReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) AccountsPending(ctx context.Context, in *AccountsPendingRequest, opts ...grpc.CallOption) (*AccountsPendingReply, error) {
+	out := new(AccountsPendingReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/AccountsPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*ReceiveReply, error) {
+	out := new(ReceiveReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/Receive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) RawRequest(ctx context.Context, in *RawActionRequest, opts ...grpc.CallOption) (*RawActionReply, error) {
+	out := new(RawActionReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/RawRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusReply, error) {
+	out := new(StatusReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.Nano/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) SubscribeVotes(ctx context.Context, in *SubscribeVotesRequest, opts ...grpc.CallOption) (Nano_SubscribeVotesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[3], "/nanoproto.Nano/SubscribeVotes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeVotesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeVotesClient interface {
+	Recv() (*VoteEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeVotesClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeVotesClient) Recv() (*VoteEntry, error) {
+	m := new(VoteEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) SubscribeStoppedElections(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeStoppedElectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[4], "/nanoproto.Nano/SubscribeStoppedElections", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeStoppedElectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeStoppedElectionsClient interface {
+	Recv() (*StoppedElectionEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeStoppedElectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeStoppedElectionsClient) Recv() (*StoppedElectionEntry, error) {
+	m := new(StoppedElectionEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) SubscribeActiveDifficulty(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeActiveDifficultyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[5], "/nanoproto.Nano/SubscribeActiveDifficulty", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeActiveDifficultyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeActiveDifficultyClient interface {
+	Recv() (*ActiveDifficultyEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeActiveDifficultyClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeActiveDifficultyClient) Recv() (*ActiveDifficultyEntry, error) {
+	m := new(ActiveDifficultyEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) SubscribeWork(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeWorkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[6], "/nanoproto.Nano/SubscribeWork", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeWorkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeWorkClient interface {
+	Recv() (*WorkEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeWorkClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeWorkClient) Recv() (*WorkEntry, error) {
+	m := new(WorkEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) SubscribeTelemetry(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeTelemetryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[7], "/nanoproto.Nano/SubscribeTelemetry", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeTelemetryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeTelemetryClient interface {
+	Recv() (*TelemetryEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeTelemetryClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeTelemetryClient) Recv() (*TelemetryEntry, error) {
+	m := new(TelemetryEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) SubscribeNewUnconfirmedBlocks(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeNewUnconfirmedBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[8], "/nanoproto.Nano/SubscribeNewUnconfirmedBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeNewUnconfirmedBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeNewUnconfirmedBlocksClient interface {
+	Recv() (*NewUnconfirmedBlockEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeNewUnconfirmedBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeNewUnconfirmedBlocksClient) Recv() (*NewUnconfirmedBlockEntry, error) {
+	m := new(NewUnconfirmedBlockEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *nanoClient) ValidateAccountNumber(ctx context.Context, in *ValidateAccountNumberRequest, opts ...grpc.CallOption) (*ValidateAccountNumberReply, error) {
-	out := new(ValidateAccountNumberReply)
-	err := c.cc.Invoke(ctx, "/nanoproto.Nano/ValidateAccountNumber", in, out, opts...)
+func (c *nanoClient) SubscribeBootstrap(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (Nano_SubscribeBootstrapClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[9], "/nanoproto.Nano/SubscribeBootstrap", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &nanoSubscribeBootstrapClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *nanoClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error) {
-	out := new(SendReply)
-	err := c.cc.Invoke(ctx, "/nanoproto.Nano/Send", in, out, opts...)
-	if err != nil {
+type Nano_SubscribeBootstrapClient interface {
+	Recv() (*BootstrapEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeBootstrapClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeBootstrapClient) Recv() (*BootstrapEntry, error) {
+	m := new(BootstrapEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 // NanoServer is the server API for Nano service.
@@ -1474,9 +4365,22 @@ type NanoServer interface {
 	Subscribe(*SubscribeRequest, Nano_SubscribeServer) error
 	AccountsBalances(context.Context, *AccountsBalancesRequest) (*AccountsBalancesReply, error)
 	AccountBalance(context.Context, *AccountBalanceRequest) (*AccountBalanceReply, error)
+	AccountInfo(context.Context, *AccountInfoRequest) (*AccountInfoReply, error)
+	AccountHistory(*AccountHistoryRequest, Nano_AccountHistoryServer) error
 	AccountCreate(context.Context, *AccountCreateRequest) (*AccountCreateReply, error)
 	ValidateAccountNumber(context.Context, *ValidateAccountNumberRequest) (*ValidateAccountNumberReply, error)
 	Send(context.Context, *SendRequest) (*SendReply, error)
+	AccountsPending(context.Context, *AccountsPendingRequest) (*AccountsPendingReply, error)
+	Receive(context.Context, *ReceiveRequest) (*ReceiveReply, error)
+	RawRequest(context.Context, *RawActionRequest) (*RawActionReply, error)
+	Status(context.Context, *StatusRequest) (*StatusReply, error)
+	SubscribeVotes(*SubscribeVotesRequest, Nano_SubscribeVotesServer) error
+	SubscribeStoppedElections(*TopicRequest, Nano_SubscribeStoppedElectionsServer) error
+	SubscribeActiveDifficulty(*TopicRequest, Nano_SubscribeActiveDifficultyServer) error
+	SubscribeWork(*TopicRequest, Nano_SubscribeWorkServer) error
+	SubscribeTelemetry(*TopicRequest, Nano_SubscribeTelemetryServer) error
+	SubscribeNewUnconfirmedBlocks(*TopicRequest, Nano_SubscribeNewUnconfirmedBlocksServer) error
+	SubscribeBootstrap(*TopicRequest, Nano_SubscribeBootstrapServer) error
 }
 
 // UnimplementedNanoServer can be embedded to have forward compatible implementations.
@@ -1498,6 +4402,12 @@ func (*UnimplementedNanoServer) AccountsBalances(ctx context.Context, req *Accou
 func (*UnimplementedNanoServer) AccountBalance(ctx context.Context, req *AccountBalanceRequest) (*AccountBalanceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountBalance not implemented")
 }
+func (*UnimplementedNanoServer) AccountInfo(ctx context.Context, req *AccountInfoRequest) (*AccountInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
+}
+func (*UnimplementedNanoServer) AccountHistory(req *AccountHistoryRequest, srv Nano_AccountHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method AccountHistory not implemented")
+}
 func (*UnimplementedNanoServer) AccountCreate(ctx context.Context, req *AccountCreateRequest) (*AccountCreateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountCreate not implemented")
 }
@@ -1507,6 +4417,39 @@ func (*UnimplementedNanoServer) ValidateAccountNumber(ctx context.Context, req *
 func (*UnimplementedNanoServer) Send(ctx context.Context, req *SendRequest) (*SendReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (*UnimplementedNanoServer) AccountsPending(ctx context.Context, req *AccountsPendingRequest) (*AccountsPendingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsPending not implemented")
+}
+func (*UnimplementedNanoServer) Receive(ctx context.Context, req *ReceiveRequest) (*ReceiveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receive not implemented")
+}
+func (*UnimplementedNanoServer) RawRequest(ctx context.Context, req *RawActionRequest) (*RawActionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawRequest not implemented")
+}
+func (*UnimplementedNanoServer) Status(ctx context.Context, req *StatusRequest) (*StatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeVotes(req *SubscribeVotesRequest, srv Nano_SubscribeVotesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeVotes not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeStoppedElections(req *TopicRequest, srv Nano_SubscribeStoppedElectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStoppedElections not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeActiveDifficulty(req *TopicRequest, srv Nano_SubscribeActiveDifficultyServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeActiveDifficulty not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeWork(req *TopicRequest, srv Nano_SubscribeWorkServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeWork not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeTelemetry(req *TopicRequest, srv Nano_SubscribeTelemetryServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTelemetry not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeNewUnconfirmedBlocks(req *TopicRequest, srv Nano_SubscribeNewUnconfirmedBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNewUnconfirmedBlocks not implemented")
+}
+func (*UnimplementedNanoServer) SubscribeBootstrap(req *TopicRequest, srv Nano_SubscribeBootstrapServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBootstrap not implemented")
+}
 
 func RegisterNanoServer(s *grpc.Server, srv NanoServer) {
 	s.RegisterService(&_Nano_serviceDesc, srv)
@@ -1608,6 +4551,45 @@ func _Nano_AccountBalance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nano_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).AccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.Nano/AccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).AccountInfo(ctx, req.(*AccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_AccountHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).AccountHistory(m, &nanoAccountHistoryServer{stream})
+}
+
+type Nano_AccountHistoryServer interface {
+	Send(*AccountHistoryEntry) error
+	grpc.ServerStream
+}
+
+type nanoAccountHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoAccountHistoryServer) Send(m *AccountHistoryEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Nano_AccountCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountCreateRequest)
 	if err := dec(in); err != nil {
@@ -1662,6 +4644,225 @@ func _Nano_Send_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nano_AccountsPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).AccountsPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.Nano/AccountsPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).AccountsPending(ctx, req.(*AccountsPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_Receive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).Receive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.Nano/Receive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).Receive(ctx, req.(*ReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_RawRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).RawRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.Nano/RawRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).RawRequest(ctx, req.(*RawActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.Nano/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_SubscribeVotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeVotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeVotes(m, &nanoSubscribeVotesServer{stream})
+}
+
+type Nano_SubscribeVotesServer interface {
+	Send(*VoteEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeVotesServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeVotesServer) Send(m *VoteEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_SubscribeStoppedElections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeStoppedElections(m, &nanoSubscribeStoppedElectionsServer{stream})
+}
+
+type Nano_SubscribeStoppedElectionsServer interface {
+	Send(*StoppedElectionEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeStoppedElectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeStoppedElectionsServer) Send(m *StoppedElectionEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_SubscribeActiveDifficulty_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeActiveDifficulty(m, &nanoSubscribeActiveDifficultyServer{stream})
+}
+
+type Nano_SubscribeActiveDifficultyServer interface {
+	Send(*ActiveDifficultyEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeActiveDifficultyServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeActiveDifficultyServer) Send(m *ActiveDifficultyEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_SubscribeWork_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeWork(m, &nanoSubscribeWorkServer{stream})
+}
+
+type Nano_SubscribeWorkServer interface {
+	Send(*WorkEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeWorkServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeWorkServer) Send(m *WorkEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_SubscribeTelemetry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeTelemetry(m, &nanoSubscribeTelemetryServer{stream})
+}
+
+type Nano_SubscribeTelemetryServer interface {
+	Send(*TelemetryEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeTelemetryServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeTelemetryServer) Send(m *TelemetryEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_SubscribeNewUnconfirmedBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeNewUnconfirmedBlocks(m, &nanoSubscribeNewUnconfirmedBlocksServer{stream})
+}
+
+type Nano_SubscribeNewUnconfirmedBlocksServer interface {
+	Send(*NewUnconfirmedBlockEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeNewUnconfirmedBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeNewUnconfirmedBlocksServer) Send(m *NewUnconfirmedBlockEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_SubscribeBootstrap_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).SubscribeBootstrap(m, &nanoSubscribeBootstrapServer{stream})
+}
+
+type Nano_SubscribeBootstrapServer interface {
+	Send(*BootstrapEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeBootstrapServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeBootstrapServer) Send(m *BootstrapEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Nano_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nanoproto.Nano",
 	HandlerType: (*NanoServer)(nil),
@@ -1678,6 +4879,10 @@ var _Nano_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountBalance",
 			Handler:    _Nano_AccountBalance_Handler,
 		},
+		{
+			MethodName: "AccountInfo",
+			Handler:    _Nano_AccountInfo_Handler,
+		},
 		{
 			MethodName: "AccountCreate",
 			Handler:    _Nano_AccountCreate_Handler,
@@ -1690,6 +4895,22 @@ var _Nano_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Nano_Send_Handler,
 		},
+		{
+			MethodName: "AccountsPending",
+			Handler:    _Nano_AccountsPending_Handler,
+		},
+		{
+			MethodName: "Receive",
+			Handler:    _Nano_Receive_Handler,
+		},
+		{
+			MethodName: "RawRequest",
+			Handler:    _Nano_RawRequest_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Nano_Status_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1702,6 +4923,46 @@ var _Nano_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Nano_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AccountHistory",
+			Handler:       _Nano_AccountHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeVotes",
+			Handler:       _Nano_SubscribeVotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeStoppedElections",
+			Handler:       _Nano_SubscribeStoppedElections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeActiveDifficulty",
+			Handler:       _Nano_SubscribeActiveDifficulty_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeWork",
+			Handler:       _Nano_SubscribeWork_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTelemetry",
+			Handler:       _Nano_SubscribeTelemetry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNewUnconfirmedBlocks",
+			Handler:       _Nano_SubscribeNewUnconfirmedBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBootstrap",
+			Handler:       _Nano_SubscribeBootstrap_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "nano.proto",
 }
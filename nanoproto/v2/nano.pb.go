@@ -0,0 +1,1963 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: v2/nano.proto
+
+// Typed version of the nanoproto API: numbers are integers, flags are booleans
+// and raw amounts are 128-bit values.
+
+package nanoproto
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Which account of a confirmed block is matched against SubscribeRequest.accounts
+type AccountMatch int32
+
+const (
+	// block.link_as_account, the destination of send blocks
+	AccountMatch_RECIPIENT AccountMatch = 0
+	// message.account, the account owning the block
+	AccountMatch_SENDER AccountMatch = 1
+	AccountMatch_EITHER AccountMatch = 2
+)
+
+var AccountMatch_name = map[int32]string{
+	0: "RECIPIENT",
+	1: "SENDER",
+	2: "EITHER",
+}
+
+var AccountMatch_value = map[string]int32{
+	"RECIPIENT": 0,
+	"SENDER":    1,
+	"EITHER":    2,
+}
+
+func (x AccountMatch) String() string {
+	return proto.EnumName(AccountMatch_name, int32(x))
+}
+
+func (AccountMatch) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{0}
+}
+
+// What to do when a subscriber falls behind and its buffer is full
+type OverflowPolicy int32
+
+const (
+	// Close the stream with RESOURCE_EXHAUSTED. This is the default: clients
+	// that do not set overflow used to be waited for, they are now disconnected
+	// when they fall behind and must resubscribe, with resume_from if the
+	// gateway keeps a journal.
+	OverflowPolicy_DISCONNECT OverflowPolicy = 0
+	// Wait for the subscriber, delaying every other subscription, up to the
+	// block timeout of the gateway (5s by default). Past it the stream is closed
+	// as with DISCONNECT.
+	OverflowPolicy_BLOCK OverflowPolicy = 1
+	// Discard the oldest buffered entry
+	OverflowPolicy_DROP_OLDEST OverflowPolicy = 2
+)
+
+var OverflowPolicy_name = map[int32]string{
+	0: "DISCONNECT",
+	1: "BLOCK",
+	2: "DROP_OLDEST",
+}
+
+var OverflowPolicy_value = map[string]int32{
+	"DISCONNECT":  0,
+	"BLOCK":       1,
+	"DROP_OLDEST": 2,
+}
+
+func (x OverflowPolicy) String() string {
+	return proto.EnumName(OverflowPolicy_name, int32(x))
+}
+
+func (OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{1}
+}
+
+// State of the gateway connection to the node websocket
+type ConnectionStatus int32
+
+const (
+	ConnectionStatus_CONNECTED    ConnectionStatus = 0
+	ConnectionStatus_RECONNECTING ConnectionStatus = 1
+)
+
+var ConnectionStatus_name = map[int32]string{
+	0: "CONNECTED",
+	1: "RECONNECTING",
+}
+
+var ConnectionStatus_value = map[string]int32{
+	"CONNECTED":    0,
+	"RECONNECTING": 1,
+}
+
+func (x ConnectionStatus) String() string {
+	return proto.EnumName(ConnectionStatus_name, int32(x))
+}
+
+func (ConnectionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{2}
+}
+
+// Amount in raw. Replies set both fields. Requests may set either, they must
+// agree if both are set.
+type Amount struct {
+	// 16 bytes, big-endian
+	Raw []byte `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	// Base 10
+	Decimal              string   `protobuf:"bytes,2,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Amount) Reset()         { *m = Amount{} }
+func (m *Amount) String() string { return proto.CompactTextString(m) }
+func (*Amount) ProtoMessage()    {}
+func (*Amount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{0}
+}
+
+func (m *Amount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Amount.Unmarshal(m, b)
+}
+func (m *Amount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Amount.Marshal(b, m, deterministic)
+}
+func (m *Amount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Amount.Merge(m, src)
+}
+func (m *Amount) XXX_Size() int {
+	return xxx_messageInfo_Amount.Size(m)
+}
+func (m *Amount) XXX_DiscardUnknown() {
+	xxx_messageInfo_Amount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Amount proto.InternalMessageInfo
+
+func (m *Amount) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
+}
+
+func (m *Amount) GetDecimal() string {
+	if m != nil {
+		return m.Decimal
+	}
+	return ""
+}
+
+// Send
+type SendRequest struct {
+	Wallet      string  `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Source      string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string  `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount      *Amount `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Idempotency key, a replayed id returns the block of the first send
+	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendRequest) Reset()         { *m = SendRequest{} }
+func (m *SendRequest) String() string { return proto.CompactTextString(m) }
+func (*SendRequest) ProtoMessage()    {}
+func (*SendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{1}
+}
+
+func (m *SendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
+}
+func (m *SendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRequest.Marshal(b, m, deterministic)
+}
+func (m *SendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRequest.Merge(m, src)
+}
+func (m *SendRequest) XXX_Size() int {
+	return xxx_messageInfo_SendRequest.Size(m)
+}
+func (m *SendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRequest proto.InternalMessageInfo
+
+func (m *SendRequest) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+func (m *SendRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SendRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *SendRequest) GetAmount() *Amount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SendRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type SendReply struct {
+	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendReply) Reset()         { *m = SendReply{} }
+func (m *SendReply) String() string { return proto.CompactTextString(m) }
+func (*SendReply) ProtoMessage()    {}
+func (*SendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{2}
+}
+
+func (m *SendReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendReply.Unmarshal(m, b)
+}
+func (m *SendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendReply.Marshal(b, m, deterministic)
+}
+func (m *SendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendReply.Merge(m, src)
+}
+func (m *SendReply) XXX_Size() int {
+	return xxx_messageInfo_SendReply.Size(m)
+}
+func (m *SendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendReply proto.InternalMessageInfo
+
+func (m *SendReply) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+// Validate Account Number
+type ValidateAccountNumberRequest struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAccountNumberRequest) Reset()         { *m = ValidateAccountNumberRequest{} }
+func (m *ValidateAccountNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateAccountNumberRequest) ProtoMessage()    {}
+func (*ValidateAccountNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{3}
+}
+
+func (m *ValidateAccountNumberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAccountNumberRequest.Unmarshal(m, b)
+}
+func (m *ValidateAccountNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAccountNumberRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateAccountNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAccountNumberRequest.Merge(m, src)
+}
+func (m *ValidateAccountNumberRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateAccountNumberRequest.Size(m)
+}
+func (m *ValidateAccountNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAccountNumberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAccountNumberRequest proto.InternalMessageInfo
+
+func (m *ValidateAccountNumberRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type ValidateAccountNumberReply struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAccountNumberReply) Reset()         { *m = ValidateAccountNumberReply{} }
+func (m *ValidateAccountNumberReply) String() string { return proto.CompactTextString(m) }
+func (*ValidateAccountNumberReply) ProtoMessage()    {}
+func (*ValidateAccountNumberReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{4}
+}
+
+func (m *ValidateAccountNumberReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAccountNumberReply.Unmarshal(m, b)
+}
+func (m *ValidateAccountNumberReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAccountNumberReply.Marshal(b, m, deterministic)
+}
+func (m *ValidateAccountNumberReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAccountNumberReply.Merge(m, src)
+}
+func (m *ValidateAccountNumberReply) XXX_Size() int {
+	return xxx_messageInfo_ValidateAccountNumberReply.Size(m)
+}
+func (m *ValidateAccountNumberReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAccountNumberReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAccountNumberReply proto.InternalMessageInfo
+
+func (m *ValidateAccountNumberReply) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+// Account Create
+type AccountCreateRequest struct {
+	Wallet               string   `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountCreateRequest) Reset()         { *m = AccountCreateRequest{} }
+func (m *AccountCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountCreateRequest) ProtoMessage()    {}
+func (*AccountCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{5}
+}
+
+func (m *AccountCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountCreateRequest.Unmarshal(m, b)
+}
+func (m *AccountCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountCreateRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCreateRequest.Merge(m, src)
+}
+func (m *AccountCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountCreateRequest.Size(m)
+}
+func (m *AccountCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountCreateRequest proto.InternalMessageInfo
+
+func (m *AccountCreateRequest) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+type AccountCreateReply struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountCreateReply) Reset()         { *m = AccountCreateReply{} }
+func (m *AccountCreateReply) String() string { return proto.CompactTextString(m) }
+func (*AccountCreateReply) ProtoMessage()    {}
+func (*AccountCreateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{6}
+}
+
+func (m *AccountCreateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountCreateReply.Unmarshal(m, b)
+}
+func (m *AccountCreateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountCreateReply.Marshal(b, m, deterministic)
+}
+func (m *AccountCreateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCreateReply.Merge(m, src)
+}
+func (m *AccountCreateReply) XXX_Size() int {
+	return xxx_messageInfo_AccountCreateReply.Size(m)
+}
+func (m *AccountCreateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCreateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountCreateReply proto.InternalMessageInfo
+
+func (m *AccountCreateReply) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// Account Balance
+type AccountBalanceRequest struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountBalanceRequest) Reset()         { *m = AccountBalanceRequest{} }
+func (m *AccountBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountBalanceRequest) ProtoMessage()    {}
+func (*AccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{7}
+}
+
+func (m *AccountBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountBalanceRequest.Unmarshal(m, b)
+}
+func (m *AccountBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalanceRequest.Merge(m, src)
+}
+func (m *AccountBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountBalanceRequest.Size(m)
+}
+func (m *AccountBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalanceRequest proto.InternalMessageInfo
+
+func (m *AccountBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type AccountBalanceReply struct {
+	Balance              *Amount  `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Pending              *Amount  `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountBalanceReply) Reset()         { *m = AccountBalanceReply{} }
+func (m *AccountBalanceReply) String() string { return proto.CompactTextString(m) }
+func (*AccountBalanceReply) ProtoMessage()    {}
+func (*AccountBalanceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{8}
+}
+
+func (m *AccountBalanceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountBalanceReply.Unmarshal(m, b)
+}
+func (m *AccountBalanceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountBalanceReply.Marshal(b, m, deterministic)
+}
+func (m *AccountBalanceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalanceReply.Merge(m, src)
+}
+func (m *AccountBalanceReply) XXX_Size() int {
+	return xxx_messageInfo_AccountBalanceReply.Size(m)
+}
+func (m *AccountBalanceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalanceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalanceReply proto.InternalMessageInfo
+
+func (m *AccountBalanceReply) GetBalance() *Amount {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *AccountBalanceReply) GetPending() *Amount {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// Account Balances
+type AccountsBalancesRequest struct {
+	Accounts             []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountsBalancesRequest) Reset()         { *m = AccountsBalancesRequest{} }
+func (m *AccountsBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountsBalancesRequest) ProtoMessage()    {}
+func (*AccountsBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{9}
+}
+
+func (m *AccountsBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsBalancesRequest.Unmarshal(m, b)
+}
+func (m *AccountsBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsBalancesRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountsBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsBalancesRequest.Merge(m, src)
+}
+func (m *AccountsBalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountsBalancesRequest.Size(m)
+}
+func (m *AccountsBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsBalancesRequest proto.InternalMessageInfo
+
+func (m *AccountsBalancesRequest) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type Balance struct {
+	Balance              *Amount  `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Pending              *Amount  `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Balance) Reset()         { *m = Balance{} }
+func (m *Balance) String() string { return proto.CompactTextString(m) }
+func (*Balance) ProtoMessage()    {}
+func (*Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{10}
+}
+
+func (m *Balance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Balance.Unmarshal(m, b)
+}
+func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+}
+func (m *Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Balance.Merge(m, src)
+}
+func (m *Balance) XXX_Size() int {
+	return xxx_messageInfo_Balance.Size(m)
+}
+func (m *Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Balance proto.InternalMessageInfo
+
+func (m *Balance) GetBalance() *Amount {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *Balance) GetPending() *Amount {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+type AccountsBalancesReply struct {
+	Balances             map[string]*Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AccountsBalancesReply) Reset()         { *m = AccountsBalancesReply{} }
+func (m *AccountsBalancesReply) String() string { return proto.CompactTextString(m) }
+func (*AccountsBalancesReply) ProtoMessage()    {}
+func (*AccountsBalancesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{11}
+}
+
+func (m *AccountsBalancesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountsBalancesReply.Unmarshal(m, b)
+}
+func (m *AccountsBalancesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountsBalancesReply.Marshal(b, m, deterministic)
+}
+func (m *AccountsBalancesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsBalancesReply.Merge(m, src)
+}
+func (m *AccountsBalancesReply) XXX_Size() int {
+	return xxx_messageInfo_AccountsBalancesReply.Size(m)
+}
+func (m *AccountsBalancesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsBalancesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsBalancesReply proto.InternalMessageInfo
+
+func (m *AccountsBalancesReply) GetBalances() map[string]*Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// BlockInfo Request
+type BlockInfoRequest struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockInfoRequest) Reset()         { *m = BlockInfoRequest{} }
+func (m *BlockInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockInfoRequest) ProtoMessage()    {}
+func (*BlockInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{12}
+}
+
+func (m *BlockInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfoRequest.Unmarshal(m, b)
+}
+func (m *BlockInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfoRequest.Merge(m, src)
+}
+func (m *BlockInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockInfoRequest.Size(m)
+}
+func (m *BlockInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfoRequest proto.InternalMessageInfo
+
+func (m *BlockInfoRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type BlockInfoReply struct {
+	BlockAccount string  `protobuf:"bytes,1,opt,name=block_account,json=blockAccount,proto3" json:"block_account,omitempty"`
+	Amount       *Amount `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance      *Amount `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Height       uint64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Seconds since the epoch
+	LocalTimestamp       uint64         `protobuf:"varint,5,opt,name=local_timestamp,json=localTimestamp,proto3" json:"local_timestamp,omitempty"`
+	Confirmed            bool           `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Contents             *BlockContents `protobuf:"bytes,7,opt,name=contents,proto3" json:"contents,omitempty"`
+	Subtype              string         `protobuf:"bytes,8,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BlockInfoReply) Reset()         { *m = BlockInfoReply{} }
+func (m *BlockInfoReply) String() string { return proto.CompactTextString(m) }
+func (*BlockInfoReply) ProtoMessage()    {}
+func (*BlockInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{13}
+}
+
+func (m *BlockInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfoReply.Unmarshal(m, b)
+}
+func (m *BlockInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfoReply.Marshal(b, m, deterministic)
+}
+func (m *BlockInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfoReply.Merge(m, src)
+}
+func (m *BlockInfoReply) XXX_Size() int {
+	return xxx_messageInfo_BlockInfoReply.Size(m)
+}
+func (m *BlockInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfoReply proto.InternalMessageInfo
+
+func (m *BlockInfoReply) GetBlockAccount() string {
+	if m != nil {
+		return m.BlockAccount
+	}
+	return ""
+}
+
+func (m *BlockInfoReply) GetAmount() *Amount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *BlockInfoReply) GetBalance() *Amount {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *BlockInfoReply) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockInfoReply) GetLocalTimestamp() uint64 {
+	if m != nil {
+		return m.LocalTimestamp
+	}
+	return 0
+}
+
+func (m *BlockInfoReply) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *BlockInfoReply) GetContents() *BlockContents {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *BlockInfoReply) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+type BlockContents struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Previous             string   `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	Representative       string   `protobuf:"bytes,4,opt,name=representative,proto3" json:"representative,omitempty"`
+	Balance              *Amount  `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Link                 string   `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	LinkAsAccount        string   `protobuf:"bytes,7,opt,name=link_as_account,json=linkAsAccount,proto3" json:"link_as_account,omitempty"`
+	Signature            string   `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	Work                 string   `protobuf:"bytes,9,opt,name=work,proto3" json:"work,omitempty"`
+	Subtype              string   `protobuf:"bytes,10,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockContents) Reset()         { *m = BlockContents{} }
+func (m *BlockContents) String() string { return proto.CompactTextString(m) }
+func (*BlockContents) ProtoMessage()    {}
+func (*BlockContents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{14}
+}
+
+func (m *BlockContents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockContents.Unmarshal(m, b)
+}
+func (m *BlockContents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockContents.Marshal(b, m, deterministic)
+}
+func (m *BlockContents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContents.Merge(m, src)
+}
+func (m *BlockContents) XXX_Size() int {
+	return xxx_messageInfo_BlockContents.Size(m)
+}
+func (m *BlockContents) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContents proto.InternalMessageInfo
+
+func (m *BlockContents) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BlockContents) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *BlockContents) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *BlockContents) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
+}
+
+func (m *BlockContents) GetBalance() *Amount {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *BlockContents) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *BlockContents) GetLinkAsAccount() string {
+	if m != nil {
+		return m.LinkAsAccount
+	}
+	return ""
+}
+
+func (m *BlockContents) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *BlockContents) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+func (m *BlockContents) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+// BlocksInfo Request
+type BlocksInfoRequest struct {
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// Stream a not_found reply for unknown hashes instead of failing
+	IncludeNotFound      bool     `protobuf:"varint,2,opt,name=include_not_found,json=includeNotFound,proto3" json:"include_not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksInfoRequest) Reset()         { *m = BlocksInfoRequest{} }
+func (m *BlocksInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocksInfoRequest) ProtoMessage()    {}
+func (*BlocksInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{15}
+}
+
+func (m *BlocksInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksInfoRequest.Unmarshal(m, b)
+}
+func (m *BlocksInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *BlocksInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksInfoRequest.Merge(m, src)
+}
+func (m *BlocksInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_BlocksInfoRequest.Size(m)
+}
+func (m *BlocksInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksInfoRequest proto.InternalMessageInfo
+
+func (m *BlocksInfoRequest) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *BlocksInfoRequest) GetIncludeNotFound() bool {
+	if m != nil {
+		return m.IncludeNotFound
+	}
+	return false
+}
+
+type BlocksInfoReply struct {
+	BlockHash string          `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Block     *BlockInfoReply `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Set instead of block for unknown hashes when include_not_found is requested
+	NotFound             bool     `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksInfoReply) Reset()         { *m = BlocksInfoReply{} }
+func (m *BlocksInfoReply) String() string { return proto.CompactTextString(m) }
+func (*BlocksInfoReply) ProtoMessage()    {}
+func (*BlocksInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{16}
+}
+
+func (m *BlocksInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksInfoReply.Unmarshal(m, b)
+}
+func (m *BlocksInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksInfoReply.Marshal(b, m, deterministic)
+}
+func (m *BlocksInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksInfoReply.Merge(m, src)
+}
+func (m *BlocksInfoReply) XXX_Size() int {
+	return xxx_messageInfo_BlocksInfoReply.Size(m)
+}
+func (m *BlocksInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksInfoReply proto.InternalMessageInfo
+
+func (m *BlocksInfoReply) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *BlocksInfoReply) GetBlock() *BlockInfoReply {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlocksInfoReply) GetNotFound() bool {
+	if m != nil {
+		return m.NotFound
+	}
+	return false
+}
+
+type SubscribeRequest struct {
+	Accounts []string     `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Match    AccountMatch `protobuf:"varint,2,opt,name=match,proto3,enum=nanoproto.v2.AccountMatch" json:"match,omitempty"`
+	// Block subtypes to deliver: send, receive, change, epoch. Empty for all.
+	Subtypes []string `protobuf:"bytes,3,rep,name=subtypes,proto3" json:"subtypes,omitempty"`
+	// Minimum amount. No minimum if not set.
+	MinAmount *Amount        `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	Overflow  OverflowPolicy `protobuf:"varint,5,opt,name=overflow,proto3,enum=nanoproto.v2.OverflowPolicy" json:"overflow,omitempty"`
+	// Sequence of the last entry received on a previous stream. Missed entries
+	// are replayed before the live ones. OUT_OF_RANGE if they are no longer
+	// retained, or if the sequence is ahead of the gateway journal. Unless the
+	// gateway journals every account, the confirmations of accounts no stream
+	// was subscribed to are not retained.
+	ResumeFrom           uint64   `protobuf:"varint,6,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{17}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetMatch() AccountMatch {
+	if m != nil {
+		return m.Match
+	}
+	return AccountMatch_RECIPIENT
+}
+
+func (m *SubscribeRequest) GetSubtypes() []string {
+	if m != nil {
+		return m.Subtypes
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetMinAmount() *Amount {
+	if m != nil {
+		return m.MinAmount
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetOverflow() OverflowPolicy {
+	if m != nil {
+		return m.Overflow
+	}
+	return OverflowPolicy_DISCONNECT
+}
+
+func (m *SubscribeRequest) GetResumeFrom() uint64 {
+	if m != nil {
+		return m.ResumeFrom
+	}
+	return 0
+}
+
+type ElectionInfo struct {
+	// Milliseconds
+	Duration uint64 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Milliseconds since the epoch
+	Time                 uint64   `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Tally                *Amount  `protobuf:"bytes,3,opt,name=tally,proto3" json:"tally,omitempty"`
+	RequestCount         uint64   `protobuf:"varint,4,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ElectionInfo) Reset()         { *m = ElectionInfo{} }
+func (m *ElectionInfo) String() string { return proto.CompactTextString(m) }
+func (*ElectionInfo) ProtoMessage()    {}
+func (*ElectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{18}
+}
+
+func (m *ElectionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ElectionInfo.Unmarshal(m, b)
+}
+func (m *ElectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ElectionInfo.Marshal(b, m, deterministic)
+}
+func (m *ElectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionInfo.Merge(m, src)
+}
+func (m *ElectionInfo) XXX_Size() int {
+	return xxx_messageInfo_ElectionInfo.Size(m)
+}
+func (m *ElectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionInfo proto.InternalMessageInfo
+
+func (m *ElectionInfo) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ElectionInfo) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *ElectionInfo) GetTally() *Amount {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+func (m *ElectionInfo) GetRequestCount() uint64 {
+	if m != nil {
+		return m.RequestCount
+	}
+	return 0
+}
+
+type SubscriptionMessage struct {
+	Account              string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount               *Amount            `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Hash                 string             `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	ConfirmationType     string             `protobuf:"bytes,4,opt,name=confirmation_type,json=confirmationType,proto3" json:"confirmation_type,omitempty"`
+	ElectionInfo         *ElectionInfo      `protobuf:"bytes,5,opt,name=election_info,json=electionInfo,proto3" json:"election_info,omitempty"`
+	Block                *SubscriptionBlock `protobuf:"bytes,6,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SubscriptionMessage) Reset()         { *m = SubscriptionMessage{} }
+func (m *SubscriptionMessage) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionMessage) ProtoMessage()    {}
+func (*SubscriptionMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{19}
+}
+
+func (m *SubscriptionMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionMessage.Unmarshal(m, b)
+}
+func (m *SubscriptionMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionMessage.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionMessage.Merge(m, src)
+}
+func (m *SubscriptionMessage) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionMessage.Size(m)
+}
+func (m *SubscriptionMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionMessage proto.InternalMessageInfo
+
+func (m *SubscriptionMessage) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetAmount() *Amount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SubscriptionMessage) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetConfirmationType() string {
+	if m != nil {
+		return m.ConfirmationType
+	}
+	return ""
+}
+
+func (m *SubscriptionMessage) GetElectionInfo() *ElectionInfo {
+	if m != nil {
+		return m.ElectionInfo
+	}
+	return nil
+}
+
+func (m *SubscriptionMessage) GetBlock() *SubscriptionBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type SubscriptionBlock struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Representative       string   `protobuf:"bytes,3,opt,name=representative,proto3" json:"representative,omitempty"`
+	Account              string   `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Work                 string   `protobuf:"bytes,5,opt,name=work,proto3" json:"work,omitempty"`
+	Signature            string   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Previous             string   `protobuf:"bytes,7,opt,name=previous,proto3" json:"previous,omitempty"`
+	LinkAsAccount        string   `protobuf:"bytes,8,opt,name=link_as_account,json=linkAsAccount,proto3" json:"link_as_account,omitempty"`
+	Subtype              string   `protobuf:"bytes,9,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	Link                 string   `protobuf:"bytes,10,opt,name=link,proto3" json:"link,omitempty"`
+	Balance              *Amount  `protobuf:"bytes,11,opt,name=balance,proto3" json:"balance,omitempty"`
+	Destination          string   `protobuf:"bytes,12,opt,name=destination,proto3" json:"destination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionBlock) Reset()         { *m = SubscriptionBlock{} }
+func (m *SubscriptionBlock) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionBlock) ProtoMessage()    {}
+func (*SubscriptionBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{20}
+}
+
+func (m *SubscriptionBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionBlock.Unmarshal(m, b)
+}
+func (m *SubscriptionBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionBlock.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionBlock.Merge(m, src)
+}
+func (m *SubscriptionBlock) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionBlock.Size(m)
+}
+func (m *SubscriptionBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionBlock proto.InternalMessageInfo
+
+func (m *SubscriptionBlock) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetLinkAsAccount() string {
+	if m != nil {
+		return m.LinkAsAccount
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetSubtype() string {
+	if m != nil {
+		return m.Subtype
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *SubscriptionBlock) GetBalance() *Amount {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *SubscriptionBlock) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// Entries with topic "status" carry no message, only a connection status change
+type SubscriptionEntry struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Milliseconds since the epoch
+	Time    uint64               `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message *SubscriptionMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Status  ConnectionStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=nanoproto.v2.ConnectionStatus" json:"status,omitempty"`
+	// Entries dropped so far on this stream because of DROP_OLDEST
+	Dropped uint64 `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// Gateway sequence number, increasing with every confirmation
+	Sequence             uint64   `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionEntry) Reset()         { *m = SubscriptionEntry{} }
+func (m *SubscriptionEntry) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionEntry) ProtoMessage()    {}
+func (*SubscriptionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e591cef410f17e, []int{21}
+}
+
+func (m *SubscriptionEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionEntry.Unmarshal(m, b)
+}
+func (m *SubscriptionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionEntry.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionEntry.Merge(m, src)
+}
+func (m *SubscriptionEntry) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionEntry.Size(m)
+}
+func (m *SubscriptionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionEntry proto.InternalMessageInfo
+
+func (m *SubscriptionEntry) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *SubscriptionEntry) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SubscriptionEntry) GetMessage() *SubscriptionMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SubscriptionEntry) GetStatus() ConnectionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ConnectionStatus_CONNECTED
+}
+
+func (m *SubscriptionEntry) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *SubscriptionEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("nanoproto.v2.AccountMatch", AccountMatch_name, AccountMatch_value)
+	proto.RegisterEnum("nanoproto.v2.OverflowPolicy", OverflowPolicy_name, OverflowPolicy_value)
+	proto.RegisterEnum("nanoproto.v2.ConnectionStatus", ConnectionStatus_name, ConnectionStatus_value)
+	proto.RegisterType((*Amount)(nil), "nanoproto.v2.Amount")
+	proto.RegisterType((*SendRequest)(nil), "nanoproto.v2.SendRequest")
+	proto.RegisterType((*SendReply)(nil), "nanoproto.v2.SendReply")
+	proto.RegisterType((*ValidateAccountNumberRequest)(nil), "nanoproto.v2.ValidateAccountNumberRequest")
+	proto.RegisterType((*ValidateAccountNumberReply)(nil), "nanoproto.v2.ValidateAccountNumberReply")
+	proto.RegisterType((*AccountCreateRequest)(nil), "nanoproto.v2.AccountCreateRequest")
+	proto.RegisterType((*AccountCreateReply)(nil), "nanoproto.v2.AccountCreateReply")
+	proto.RegisterType((*AccountBalanceRequest)(nil), "nanoproto.v2.AccountBalanceRequest")
+	proto.RegisterType((*AccountBalanceReply)(nil), "nanoproto.v2.AccountBalanceReply")
+	proto.RegisterType((*AccountsBalancesRequest)(nil), "nanoproto.v2.AccountsBalancesRequest")
+	proto.RegisterType((*Balance)(nil), "nanoproto.v2.Balance")
+	proto.RegisterType((*AccountsBalancesReply)(nil), "nanoproto.v2.AccountsBalancesReply")
+	proto.RegisterMapType((map[string]*Balance)(nil), "nanoproto.v2.AccountsBalancesReply.BalancesEntry")
+	proto.RegisterType((*BlockInfoRequest)(nil), "nanoproto.v2.BlockInfoRequest")
+	proto.RegisterType((*BlockInfoReply)(nil), "nanoproto.v2.BlockInfoReply")
+	proto.RegisterType((*BlockContents)(nil), "nanoproto.v2.BlockContents")
+	proto.RegisterType((*BlocksInfoRequest)(nil), "nanoproto.v2.BlocksInfoRequest")
+	proto.RegisterType((*BlocksInfoReply)(nil), "nanoproto.v2.BlocksInfoReply")
+	proto.RegisterType((*SubscribeRequest)(nil), "nanoproto.v2.SubscribeRequest")
+	proto.RegisterType((*ElectionInfo)(nil), "nanoproto.v2.ElectionInfo")
+	proto.RegisterType((*SubscriptionMessage)(nil), "nanoproto.v2.SubscriptionMessage")
+	proto.RegisterType((*SubscriptionBlock)(nil), "nanoproto.v2.SubscriptionBlock")
+	proto.RegisterType((*SubscriptionEntry)(nil), "nanoproto.v2.SubscriptionEntry")
+}
+
+func init() { proto.RegisterFile("v2/nano.proto", fileDescriptor_e9e591cef410f17e) }
+
+var fileDescriptor_e9e591cef410f17e = []byte{
+	// 1480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x69, 0xfd, 0xe3, 0x58, 0x92, 0xe9, 0x8d, 0x9d, 0xe8, 0x29, 0x4e, 0xe2, 0x30, 0x78,
+	0x79, 0x86, 0xf3, 0x20, 0x27, 0xf2, 0xcb, 0x6b, 0xd0, 0x04, 0x28, 0x62, 0x59, 0x69, 0x8c, 0x24,
+	0xb2, 0xb1, 0x36, 0x1a, 0x20, 0x17, 0x95, 0xa2, 0xd6, 0x16, 0x61, 0x92, 0xab, 0xf2, 0x8f, 0x0c,
+	0x1f, 0x7b, 0x2f, 0xd0, 0x5b, 0x6f, 0xfd, 0x20, 0x2d, 0xd0, 0x63, 0xbf, 0x4b, 0xbf, 0x41, 0xaf,
+	0xc5, 0xfe, 0x21, 0x4d, 0xd2, 0x92, 0xa2, 0x1e, 0x7a, 0xe2, 0xce, 0xec, 0xcc, 0xec, 0xec, 0xec,
+	0xcc, 0x6f, 0x76, 0x09, 0xb5, 0x49, 0x7b, 0xd7, 0x33, 0x3d, 0xda, 0x1a, 0xfb, 0x34, 0xa4, 0xa8,
+	0xca, 0xc6, 0x7c, 0xd8, 0x9a, 0xb4, 0x8d, 0xff, 0x41, 0xe9, 0xb5, 0x4b, 0x23, 0x2f, 0x44, 0x3a,
+	0x2c, 0xfb, 0xe6, 0x65, 0x43, 0xd9, 0x52, 0xb6, 0xab, 0x98, 0x0d, 0x51, 0x03, 0xca, 0x43, 0x62,
+	0xd9, 0xae, 0xe9, 0x34, 0xd4, 0x2d, 0x65, 0x5b, 0xc3, 0x31, 0x69, 0xfc, 0xac, 0xc0, 0xca, 0x09,
+	0xf1, 0x86, 0x98, 0x7c, 0x17, 0x91, 0x20, 0x44, 0xb7, 0xa1, 0x74, 0x69, 0x3a, 0x0e, 0x09, 0xb9,
+	0xba, 0x86, 0x25, 0xc5, 0xf8, 0x01, 0x8d, 0x7c, 0x8b, 0x48, 0x03, 0x92, 0x42, 0x5b, 0xb0, 0x32,
+	0x24, 0x41, 0x68, 0x7b, 0x66, 0x68, 0x53, 0xaf, 0xb1, 0xcc, 0x27, 0xd3, 0x2c, 0xf4, 0x5f, 0x28,
+	0x99, 0xdc, 0xaf, 0x46, 0x61, 0x4b, 0xd9, 0x5e, 0x69, 0xaf, 0xb7, 0xd2, 0x6e, 0xb7, 0x84, 0xcf,
+	0x58, 0xca, 0xa0, 0x3a, 0xa8, 0xf6, 0xb0, 0x51, 0xe4, 0x66, 0x54, 0x7b, 0x68, 0x3c, 0x04, 0x4d,
+	0xb8, 0x37, 0x76, 0xae, 0xd0, 0x3a, 0x14, 0x07, 0x0e, 0xb5, 0x2e, 0xa4, 0x6f, 0x82, 0x30, 0x5e,
+	0xc0, 0xe6, 0x37, 0xa6, 0x63, 0x0f, 0xcd, 0x90, 0xbc, 0xb6, 0x2c, 0x66, 0xa5, 0x17, 0xb9, 0x03,
+	0xe2, 0xc7, 0x5b, 0x6a, 0x40, 0xd9, 0x14, 0x7c, 0xa9, 0x17, 0x93, 0x46, 0x1b, 0x9a, 0x33, 0x34,
+	0xe5, 0x6a, 0x13, 0x36, 0xcb, 0xb5, 0x2a, 0x58, 0x10, 0x46, 0x0b, 0xd6, 0xa5, 0x6c, 0xc7, 0x27,
+	0x66, 0x48, 0x3e, 0x13, 0x38, 0xa3, 0x05, 0x28, 0x27, 0xcf, 0x6c, 0xcf, 0xf6, 0xe9, 0x19, 0x6c,
+	0x48, 0xf9, 0x7d, 0xd3, 0x31, 0x3d, 0x8b, 0x7c, 0x7e, 0x1b, 0x11, 0xdc, 0xca, 0xab, 0xb0, 0x35,
+	0x5a, 0x50, 0x1e, 0x08, 0xba, 0xa1, 0xcc, 0x89, 0x7c, 0x2c, 0xc4, 0xe4, 0xc7, 0xc4, 0x1b, 0xda,
+	0xde, 0x79, 0x43, 0x9d, 0x27, 0x2f, 0x85, 0x8c, 0xe7, 0x70, 0x47, 0x2e, 0x1b, 0xc8, 0x75, 0x83,
+	0xd8, 0xd7, 0x26, 0x54, 0xa4, 0x73, 0x41, 0x43, 0xd9, 0x5a, 0xde, 0xd6, 0x70, 0x42, 0x1b, 0x36,
+	0x94, 0xf7, 0xaf, 0x57, 0xfc, 0x47, 0x3d, 0xfc, 0x55, 0x81, 0x8d, 0x9b, 0x2e, 0xb2, 0xd8, 0x7c,
+	0x80, 0x8a, 0x34, 0x2a, 0x1c, 0x5c, 0x69, 0x3f, 0xcb, 0x99, 0x9a, 0xa6, 0xd6, 0x8a, 0xa9, 0xae,
+	0x17, 0xfa, 0x57, 0x38, 0x31, 0xd1, 0xc4, 0x50, 0xcb, 0x4c, 0xb1, 0x12, 0xbc, 0x20, 0x57, 0xf2,
+	0xa0, 0xd8, 0x10, 0x3d, 0xe1, 0xd9, 0x14, 0x11, 0xe9, 0xf9, 0x46, 0x76, 0xb9, 0xf8, 0xe0, 0x84,
+	0xcc, 0x97, 0xea, 0x0b, 0xc5, 0x78, 0x0c, 0xfa, 0x3e, 0xcb, 0xef, 0x43, 0xef, 0x8c, 0xc6, 0x71,
+	0x45, 0x50, 0x18, 0x99, 0xc1, 0x48, 0xda, 0xe5, 0x63, 0xe3, 0x77, 0x15, 0xea, 0x29, 0x41, 0xb6,
+	0xbb, 0x47, 0x50, 0xe3, 0xa5, 0xd1, 0xcf, 0x26, 0x4c, 0x95, 0x33, 0xe5, 0xce, 0x52, 0x75, 0xa9,
+	0x2e, 0x50, 0x97, 0xa9, 0xa3, 0x5a, 0x5e, 0xe4, 0xa8, 0x6e, 0x43, 0x69, 0x44, 0xec, 0xf3, 0x91,
+	0xa8, 0xfa, 0x02, 0x96, 0x14, 0xfa, 0x0f, 0xac, 0x3a, 0xd4, 0x32, 0x9d, 0x7e, 0x68, 0xbb, 0x24,
+	0x08, 0x4d, 0x77, 0xcc, 0x8b, 0xbd, 0x80, 0xeb, 0x9c, 0x7d, 0x1a, 0x73, 0xd1, 0x26, 0x68, 0x16,
+	0xf5, 0xce, 0x6c, 0xdf, 0x25, 0xc3, 0x46, 0x89, 0x57, 0xe0, 0x35, 0x03, 0x7d, 0x01, 0x15, 0x8b,
+	0x7a, 0x21, 0x61, 0x09, 0x56, 0xe6, 0xfe, 0xdc, 0xcd, 0x05, 0x94, 0x6d, 0xb5, 0x23, 0x45, 0x70,
+	0x22, 0xcc, 0xaa, 0x28, 0x88, 0x06, 0xe1, 0xd5, 0x98, 0x34, 0x2a, 0xa2, 0x8a, 0x24, 0x69, 0xfc,
+	0xa2, 0x42, 0x2d, 0xa3, 0xc5, 0xa2, 0xcd, 0x05, 0x65, 0xb4, 0xd9, 0x38, 0x5d, 0x85, 0x6a, 0xa6,
+	0x0a, 0x59, 0xce, 0x8f, 0x7d, 0x32, 0xb1, 0x69, 0x14, 0x48, 0x18, 0x4c, 0x68, 0xf4, 0x18, 0xea,
+	0x3e, 0x19, 0xfb, 0x24, 0x20, 0x5e, 0x68, 0x86, 0xf6, 0x84, 0xf0, 0xa8, 0x68, 0x38, 0xc7, 0x4d,
+	0x47, 0xb9, 0xb8, 0x48, 0x94, 0x11, 0x14, 0x1c, 0xdb, 0xbb, 0xe0, 0xf1, 0xd1, 0x30, 0x1f, 0xa3,
+	0xc7, 0xb0, 0xca, 0xbe, 0x7d, 0x33, 0x48, 0x8e, 0xbf, 0xcc, 0xa7, 0x6b, 0x8c, 0xfd, 0x3a, 0x88,
+	0xcf, 0x7f, 0x13, 0xb4, 0xc0, 0x3e, 0xf7, 0xcc, 0x30, 0xf2, 0xe3, 0x58, 0x5c, 0x33, 0x98, 0xe5,
+	0x4b, 0xea, 0x5f, 0x34, 0x34, 0x61, 0x99, 0x8d, 0xd3, 0xb1, 0x83, 0x6c, 0xec, 0x3e, 0xc2, 0x1a,
+	0x0f, 0x5d, 0x90, 0x4e, 0x56, 0x96, 0x02, 0x66, 0x30, 0x22, 0x31, 0x04, 0x48, 0x0a, 0xed, 0xc0,
+	0x9a, 0xed, 0x59, 0x4e, 0x34, 0x24, 0x7d, 0x8f, 0x86, 0xfd, 0x33, 0x1a, 0x79, 0x43, 0x1e, 0xcc,
+	0x0a, 0x5e, 0x95, 0x13, 0x3d, 0x1a, 0xbe, 0x61, 0x6c, 0xe3, 0x7b, 0x05, 0x56, 0xd3, 0x96, 0x59,
+	0x76, 0xdf, 0x03, 0x10, 0xd9, 0x9d, 0x2a, 0x05, 0x8d, 0x73, 0xde, 0x9a, 0xc1, 0x08, 0xb5, 0xe3,
+	0x26, 0x21, 0xd2, 0x7a, 0x73, 0x4a, 0x5e, 0x24, 0xb6, 0x64, 0x0b, 0x41, 0x77, 0x41, 0xbb, 0x76,
+	0x65, 0x99, 0xbb, 0x52, 0xf1, 0x62, 0x1f, 0x7e, 0x50, 0x41, 0x3f, 0x89, 0x06, 0x81, 0xe5, 0xdb,
+	0x03, 0xb2, 0x00, 0xc2, 0xa1, 0xa7, 0x50, 0x74, 0xcd, 0xd0, 0x1a, 0x71, 0x0f, 0xea, 0xed, 0xe6,
+	0x54, 0x64, 0xf9, 0xc0, 0x24, 0xb0, 0x10, 0x64, 0xd6, 0x64, 0x28, 0x59, 0xee, 0x70, 0x6b, 0x31,
+	0x8d, 0xf6, 0x00, 0x5c, 0xdb, 0xeb, 0x2f, 0xd0, 0x43, 0x35, 0xd7, 0xf6, 0xc4, 0x10, 0xbd, 0x80,
+	0x0a, 0x9d, 0x10, 0xff, 0xcc, 0xa1, 0x97, 0x3c, 0x93, 0xea, 0xf9, 0x38, 0x1c, 0xc9, 0xd9, 0x63,
+	0xea, 0xd8, 0xd6, 0x15, 0x4e, 0xa4, 0xd1, 0x03, 0x58, 0xf1, 0x49, 0x10, 0xb9, 0xa4, 0x7f, 0xe6,
+	0x53, 0x97, 0x67, 0x56, 0x01, 0x83, 0x60, 0xbd, 0xf1, 0xa9, 0x6b, 0xfc, 0xa8, 0x40, 0xb5, 0xeb,
+	0x10, 0x8b, 0x35, 0x77, 0x16, 0x48, 0xe6, 0xfc, 0x30, 0xf2, 0x45, 0xff, 0x57, 0xb8, 0x78, 0x42,
+	0xf3, 0x12, 0xb2, 0x5d, 0x01, 0x7a, 0x05, 0xcc, 0xc7, 0x68, 0x07, 0x8a, 0xa1, 0xe9, 0x38, 0x57,
+	0x73, 0x81, 0x44, 0x88, 0x30, 0x24, 0xf3, 0x45, 0xc4, 0xfb, 0x56, 0xb2, 0xff, 0x02, 0xae, 0x4a,
+	0x66, 0x87, 0xf7, 0xbf, 0x9f, 0x54, 0xb8, 0x25, 0x0f, 0x68, 0xcc, 0x56, 0xfd, 0x40, 0x82, 0xc0,
+	0x3c, 0x27, 0xb3, 0x3b, 0xe6, 0xdf, 0xc4, 0xbe, 0x18, 0x75, 0x97, 0xaf, 0x51, 0x17, 0x3d, 0x81,
+	0x35, 0x89, 0x46, 0x7c, 0xa3, 0x7d, 0x5e, 0x15, 0xa2, 0xa8, 0xf5, 0xf4, 0xc4, 0x29, 0x03, 0x8d,
+	0xaf, 0xa0, 0x46, 0x64, 0xc4, 0xfa, 0xb6, 0x77, 0x46, 0x65, 0x71, 0xe7, 0x12, 0x23, 0x1d, 0x54,
+	0x5c, 0x25, 0x29, 0x0a, 0x3d, 0x8f, 0x73, 0xba, 0xc4, 0x15, 0x1f, 0x64, 0x15, 0xd3, 0x7b, 0xe7,
+	0xf9, 0x1d, 0xdf, 0x8c, 0xfe, 0x54, 0x61, 0xed, 0xc6, 0xe4, 0x54, 0x58, 0x9b, 0x75, 0xbd, 0xbb,
+	0x09, 0x5c, 0xcb, 0x53, 0x81, 0x2b, 0x15, 0xea, 0x42, 0x36, 0xd4, 0x31, 0x90, 0x14, 0x53, 0x40,
+	0x92, 0x81, 0x9e, 0x52, 0x1e, 0x7a, 0xd2, 0x40, 0x5a, 0xbe, 0x01, 0xa4, 0x37, 0xc0, 0xad, 0x32,
+	0x0d, 0xdc, 0x52, 0x50, 0xa5, 0x65, 0xa0, 0x2a, 0x81, 0x4c, 0x48, 0x41, 0x66, 0x0a, 0x76, 0x57,
+	0x16, 0x81, 0xdd, 0xdc, 0xa5, 0xb7, 0x7a, 0xe3, 0xd2, 0x6b, 0xfc, 0xa1, 0x64, 0x23, 0x2f, 0x6e,
+	0x05, 0xeb, 0x50, 0x0c, 0xe9, 0xd8, 0xb6, 0xe2, 0xfb, 0x2b, 0x27, 0xa6, 0xd6, 0xc8, 0x4b, 0x28,
+	0xbb, 0x22, 0x8b, 0x65, 0x95, 0x3c, 0x9c, 0x7d, 0xe4, 0x32, 0xdd, 0x71, 0xac, 0x81, 0xfe, 0x0f,
+	0xa5, 0x20, 0x34, 0xc3, 0x28, 0xe0, 0x67, 0x51, 0x6f, 0xdf, 0xcf, 0xea, 0x76, 0xa8, 0xe7, 0x89,
+	0xdc, 0x3a, 0xe1, 0x52, 0x58, 0x4a, 0xf3, 0x57, 0x82, 0x4f, 0xc7, 0x63, 0x32, 0x94, 0x3d, 0x39,
+	0x26, 0x39, 0x3e, 0xb1, 0x8a, 0xf3, 0x2c, 0x71, 0x5e, 0x05, 0x9c, 0xd0, 0x3b, 0xcf, 0xa1, 0x9a,
+	0x86, 0x34, 0x54, 0x03, 0x0d, 0x77, 0x3b, 0x87, 0xc7, 0x87, 0xdd, 0xde, 0xa9, 0xbe, 0x84, 0x00,
+	0x4a, 0x27, 0xdd, 0xde, 0x41, 0x17, 0xeb, 0x0a, 0x1b, 0x77, 0x0f, 0x4f, 0xdf, 0x76, 0xb1, 0xae,
+	0xee, 0xbc, 0x82, 0x7a, 0x16, 0x83, 0x50, 0x1d, 0xe0, 0xe0, 0xf0, 0xa4, 0x73, 0xd4, 0xeb, 0x75,
+	0x3b, 0x4c, 0x53, 0x83, 0xe2, 0xfe, 0xfb, 0xa3, 0xce, 0x3b, 0x5d, 0x41, 0xab, 0xb0, 0x72, 0x80,
+	0x8f, 0x8e, 0xfb, 0x47, 0xef, 0x0f, 0xba, 0x27, 0xa7, 0xba, 0xba, 0xb3, 0x07, 0x7a, 0x7e, 0x1b,
+	0x6c, 0x61, 0xa9, 0xdc, 0x3d, 0xd0, 0x97, 0x90, 0x0e, 0x55, 0xdc, 0x95, 0x8c, 0xc3, 0xde, 0xd7,
+	0xba, 0xd2, 0xfe, 0xad, 0x08, 0x85, 0x9e, 0xe9, 0x51, 0x74, 0x0c, 0x70, 0xdd, 0x54, 0xd0, 0x83,
+	0x29, 0x1d, 0x22, 0xdd, 0xc8, 0x9a, 0xf7, 0x66, 0x0b, 0x8c, 0x9d, 0x2b, 0x63, 0xe9, 0xa9, 0x82,
+	0xde, 0x81, 0x96, 0x74, 0x16, 0x74, 0x7f, 0x66, 0xcb, 0x11, 0xf6, 0xe6, 0xb6, 0x24, 0x63, 0x09,
+	0x1d, 0x83, 0x96, 0xf4, 0x9b, 0xbc, 0xb1, 0x7c, 0x23, 0x6a, 0xce, 0xc1, 0x02, 0x9e, 0x74, 0xdc,
+	0xbd, 0x6f, 0x41, 0xcf, 0x5f, 0x68, 0xd1, 0xbf, 0x3f, 0x77, 0xe1, 0x15, 0xf6, 0x1f, 0x2d, 0x70,
+	0x2f, 0x36, 0x96, 0xd0, 0x27, 0xa8, 0x67, 0xdf, 0x20, 0x68, 0xba, 0x62, 0xf6, 0x51, 0xd3, 0x7c,
+	0x38, 0x5f, 0x48, 0xd8, 0xfe, 0x08, 0xb5, 0xcc, 0x13, 0x0a, 0x19, 0x53, 0xb5, 0x32, 0xef, 0xb1,
+	0xe6, 0xd6, 0x5c, 0x19, 0x61, 0x98, 0xc2, 0xc6, 0xd4, 0xf7, 0x1f, 0xda, 0xc9, 0x2a, 0xcf, 0x7b,
+	0x5e, 0x36, 0xb7, 0x17, 0x92, 0x15, 0x0b, 0xbe, 0x82, 0x02, 0x7b, 0xcd, 0xa2, 0x7f, 0xe5, 0x0e,
+	0xed, 0xfa, 0x01, 0xde, 0xbc, 0x33, 0x6d, 0x8a, 0x6b, 0xef, 0xef, 0x7d, 0x7a, 0x76, 0x6e, 0x87,
+	0xa3, 0x68, 0xd0, 0xb2, 0xa8, 0xbb, 0x6b, 0x3a, 0x13, 0x3b, 0x08, 0x4d, 0x9f, 0xff, 0x11, 0x18,
+	0x0f, 0x76, 0x13, 0xb5, 0xdd, 0x49, 0xfb, 0x65, 0x42, 0x0c, 0x4a, 0xfc, 0xb3, 0xf7, 0xd7, 0x00,
+	0x15, 0x1c, 0x3f, 0x62, 0x3c, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NanoClient is the client API for Nano service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NanoClient interface {
+	BlocksInfo(ctx context.Context, in *BlocksInfoRequest, opts ...grpc.CallOption) (Nano_BlocksInfoClient, error)
+	BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoReply, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Nano_SubscribeClient, error)
+	AccountsBalances(ctx context.Context, in *AccountsBalancesRequest, opts ...grpc.CallOption) (*AccountsBalancesReply, error)
+	AccountBalance(ctx context.Context, in *AccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalanceReply, error)
+	AccountCreate(ctx context.Context, in *AccountCreateRequest, opts ...grpc.CallOption) (*AccountCreateReply, error)
+	ValidateAccountNumber(ctx context.Context, in *ValidateAccountNumberRequest, opts ...grpc.CallOption) (*ValidateAccountNumberReply, error)
+	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error)
+}
+
+type nanoClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNanoClient(cc *grpc.ClientConn) NanoClient {
+	return &nanoClient{cc}
+}
+
+func (c *nanoClient) BlocksInfo(ctx context.Context, in *BlocksInfoRequest, opts ...grpc.CallOption) (Nano_BlocksInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[0], "/nanoproto.v2.Nano/BlocksInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoBlocksInfoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_BlocksInfoClient interface {
+	Recv() (*BlocksInfoReply, error)
+	grpc.ClientStream
+}
+
+type nanoBlocksInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoBlocksInfoClient) Recv() (*BlocksInfoReply, error) {
+	m := new(BlocksInfoReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoReply, error) {
+	out := new(BlockInfoReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.v2.Nano/BlockInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Nano_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Nano_serviceDesc.Streams[1], "/nanoproto.v2.Nano/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nanoSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Nano_SubscribeClient interface {
+	Recv() (*SubscriptionEntry, error)
+	grpc.ClientStream
+}
+
+type nanoSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *nanoSubscribeClient) Recv() (*SubscriptionEntry, error) {
+	m := new(SubscriptionEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nanoClient) AccountsBalances(ctx context.Context, in *AccountsBalancesRequest, opts ...grpc.CallOption) (*AccountsBalancesReply, error) {
+	out := new(AccountsBalancesReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.v2.Nano/AccountsBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) AccountBalance(ctx context.Context, in *AccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalanceReply, error) {
+	out := new(AccountBalanceReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.v2.Nano/AccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) AccountCreate(ctx context.Context, in *AccountCreateRequest, opts ...grpc.CallOption) (*AccountCreateReply, error) {
+	out := new(AccountCreateReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.v2.Nano/AccountCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) ValidateAccountNumber(ctx context.Context, in *ValidateAccountNumberRequest, opts ...grpc.CallOption) (*ValidateAccountNumberReply, error) {
+	out := new(ValidateAccountNumberReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.v2.Nano/ValidateAccountNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nanoClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error) {
+	out := new(SendReply)
+	err := c.cc.Invoke(ctx, "/nanoproto.v2.Nano/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NanoServer is the server API for Nano service.
+type NanoServer interface {
+	BlocksInfo(*BlocksInfoRequest, Nano_BlocksInfoServer) error
+	BlockInfo(context.Context, *BlockInfoRequest) (*BlockInfoReply, error)
+	Subscribe(*SubscribeRequest, Nano_SubscribeServer) error
+	AccountsBalances(context.Context, *AccountsBalancesRequest) (*AccountsBalancesReply, error)
+	AccountBalance(context.Context, *AccountBalanceRequest) (*AccountBalanceReply, error)
+	AccountCreate(context.Context, *AccountCreateRequest) (*AccountCreateReply, error)
+	ValidateAccountNumber(context.Context, *ValidateAccountNumberRequest) (*ValidateAccountNumberReply, error)
+	Send(context.Context, *SendRequest) (*SendReply, error)
+}
+
+// UnimplementedNanoServer can be embedded to have forward compatible implementations.
+type UnimplementedNanoServer struct {
+}
+
+func (*UnimplementedNanoServer) BlocksInfo(req *BlocksInfoRequest, srv Nano_BlocksInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method BlocksInfo not implemented")
+}
+func (*UnimplementedNanoServer) BlockInfo(ctx context.Context, req *BlockInfoRequest) (*BlockInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockInfo not implemented")
+}
+func (*UnimplementedNanoServer) Subscribe(req *SubscribeRequest, srv Nano_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedNanoServer) AccountsBalances(ctx context.Context, req *AccountsBalancesRequest) (*AccountsBalancesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsBalances not implemented")
+}
+func (*UnimplementedNanoServer) AccountBalance(ctx context.Context, req *AccountBalanceRequest) (*AccountBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBalance not implemented")
+}
+func (*UnimplementedNanoServer) AccountCreate(ctx context.Context, req *AccountCreateRequest) (*AccountCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountCreate not implemented")
+}
+func (*UnimplementedNanoServer) ValidateAccountNumber(ctx context.Context, req *ValidateAccountNumberRequest) (*ValidateAccountNumberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAccountNumber not implemented")
+}
+func (*UnimplementedNanoServer) Send(ctx context.Context, req *SendRequest) (*SendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+
+func RegisterNanoServer(s *grpc.Server, srv NanoServer) {
+	s.RegisterService(&_Nano_serviceDesc, srv)
+}
+
+func _Nano_BlocksInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).BlocksInfo(m, &nanoBlocksInfoServer{stream})
+}
+
+type Nano_BlocksInfoServer interface {
+	Send(*BlocksInfoReply) error
+	grpc.ServerStream
+}
+
+type nanoBlocksInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoBlocksInfoServer) Send(m *BlocksInfoReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_BlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).BlockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.v2.Nano/BlockInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).BlockInfo(ctx, req.(*BlockInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NanoServer).Subscribe(m, &nanoSubscribeServer{stream})
+}
+
+type Nano_SubscribeServer interface {
+	Send(*SubscriptionEntry) error
+	grpc.ServerStream
+}
+
+type nanoSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *nanoSubscribeServer) Send(m *SubscriptionEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Nano_AccountsBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).AccountsBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.v2.Nano/AccountsBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).AccountsBalances(ctx, req.(*AccountsBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_AccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).AccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.v2.Nano/AccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).AccountBalance(ctx, req.(*AccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_AccountCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).AccountCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.v2.Nano/AccountCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).AccountCreate(ctx, req.(*AccountCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_ValidateAccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAccountNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).ValidateAccountNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.v2.Nano/ValidateAccountNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).ValidateAccountNumber(ctx, req.(*ValidateAccountNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nano_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NanoServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nanoproto.v2.Nano/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NanoServer).Send(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Nano_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nanoproto.v2.Nano",
+	HandlerType: (*NanoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockInfo",
+			Handler:    _Nano_BlockInfo_Handler,
+		},
+		{
+			MethodName: "AccountsBalances",
+			Handler:    _Nano_AccountsBalances_Handler,
+		},
+		{
+			MethodName: "AccountBalance",
+			Handler:    _Nano_AccountBalance_Handler,
+		},
+		{
+			MethodName: "AccountCreate",
+			Handler:    _Nano_AccountCreate_Handler,
+		},
+		{
+			MethodName: "ValidateAccountNumber",
+			Handler:    _Nano_ValidateAccountNumber_Handler,
+		},
+		{
+			MethodName: "Send",
+			Handler:    _Nano_Send_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BlocksInfo",
+			Handler:       _Nano_BlocksInfo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Nano_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v2/nano.proto",
+}
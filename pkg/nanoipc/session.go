@@ -13,13 +13,20 @@ import (
 
 // A Session represents a persistent connection to the Nano node.
 type Session struct {
-	mutex      sync.Mutex
+	// Buffered channel of size one used as a lock which can be abandoned
+	// when the request context is done
+	lock       chan struct{}
+	lockOnce   sync.Once
 	connection net.Conn
+	// Guards the connection deadlines against a concurrent abort
+	deadlineMutex sync.Mutex
+	aborted       bool
 	// True if the session has been connected to the node
 	Connected bool
-	// Read and Write timeout. Default is 30 seconds.
+	// Read and write timeout in seconds, the fallback when a request context
+	// has no deadline. Default is 30.
 	TimeoutReadWrite int
-	// Connection timeout. Default is 10 seconds.
+	// Connection timeout in seconds. Default is 15.
 	TimeoutConnection int
 }
 
@@ -37,6 +44,12 @@ func Init(l *log.Logger) {
 // of 15 seconds is used.
 // connectionString is an URI of the form tcp://host:port or local:///path/to/domainsocketfile
 func (s *Session) Connect(connectionString string) *Error {
+	return s.ConnectContext(context.Background(), connectionString)
+}
+
+// ConnectContext connects to a node like Connect, giving up when ctx is done
+// before the connection timeout. The returned error has then category "Context".
+func (s *Session) ConnectContext(ctx context.Context, connectionString string) *Error {
 	var connError *Error
 	uri, err := url.Parse(connectionString)
 	if err != nil {
@@ -55,15 +68,18 @@ func (s *Session) Connect(connectionString string) *Error {
 			s.TimeoutConnection = 15
 		}
 		if s.TimeoutReadWrite == 0 {
-			s.TimeoutReadWrite = 30000
+			s.TimeoutReadWrite = 30
 		}
 		dialContext := (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   time.Duration(s.TimeoutConnection) * time.Second,
 		}).DialContext
 
-		con, err := dialContext(context.Background(), scheme, host)
-		if err != nil {
+		con, err := dialContext(ctx, scheme, host)
+		if err != nil && contextDone(ctx) != nil {
+			connError = contextError(ctx)
+			s.Connected = false
+		} else if err != nil {
 			connError = &Error{1, err.Error(), "Connection"}
 			s.Connected = false
 			logger.Error(err.Error())
@@ -76,10 +92,49 @@ func (s *Session) Connect(connectionString string) *Error {
 	return connError
 }
 
+// acquire takes the session lock, giving up when ctx is done
+func (s *Session) acquire(ctx context.Context) *Error {
+	s.lockOnce.Do(func() {
+		s.lock = make(chan struct{}, 1)
+	})
+
+	select {
+	case s.lock <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return contextError(ctx)
+	}
+}
+
+func (s *Session) release() {
+	<-s.lock
+}
+
+// contextDone returns the ctx error, or context.DeadlineExceeded if the
+// deadline has passed but ctx has not been marked done yet
+func contextDone(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+// contextError returns the error reported when ctx is cancelled or its deadline expires
+func contextError(ctx context.Context) *Error {
+	err := contextDone(ctx)
+	if err == nil {
+		err = context.Canceled
+	}
+	return &Error{1, err.Error(), "Context"}
+}
+
 // Close the underlying connection to the node
 func (s *Session) Close() *Error {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
+	_ = s.acquire(context.Background())
+	defer s.release()
 
 	var err *Error
 	if s.Connected {
@@ -93,27 +148,76 @@ func (s *Session) Close() *Error {
 }
 
 // Updates the write deadline using Session#TimeoutReadWrite
-func (s *Session) updateWriteDeadline() {
-	s.connection.SetWriteDeadline(time.Now().Add(time.Duration(s.TimeoutReadWrite) * time.Second))
+func (s *Session) updateWriteDeadline(ctx context.Context) {
+	s.deadlineMutex.Lock()
+	defer s.deadlineMutex.Unlock()
+	s.connection.SetWriteDeadline(s.deadline(ctx))
 }
 
 // Updates the read deadline using Session#TimeoutReadWrite
-func (s *Session) updateReadDeadline() {
-	s.connection.SetReadDeadline(time.Now().Add(time.Duration(s.TimeoutReadWrite) * time.Second))
+func (s *Session) updateReadDeadline(ctx context.Context) {
+	s.deadlineMutex.Lock()
+	defer s.deadlineMutex.Unlock()
+	s.connection.SetReadDeadline(s.deadline(ctx))
+}
+
+// deadline returns the next I/O deadline, never later than the ctx deadline.
+// Must be called with deadlineMutex held.
+func (s *Session) deadline(ctx context.Context) time.Time {
+	if s.aborted {
+		return time.Now()
+	}
+	deadline := time.Now().Add(time.Duration(s.TimeoutReadWrite) * time.Second)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	return deadline
 }
 
+// abort interrupts any pending read or write on the connection
+func (s *Session) abort() {
+	s.deadlineMutex.Lock()
+	defer s.deadlineMutex.Unlock()
+	s.aborted = true
+	s.connection.SetDeadline(time.Now())
+}
+
+// Encoding is the payload encoding announced in the request preamble.
+type Encoding byte
+
+const (
+	// EncodingJSON is the legacy JSON-over-IPC encoding
+	EncodingJSON Encoding = 1
+	// EncodingFlatbuffers carries a nanoapi.Envelope flatbuffer
+	EncodingFlatbuffers Encoding = 3
+)
+
 // Request send JSON request to the node via IPC. The session must be connected.
 // This method is threadsafe. Use a larger pool size to increase concurrency
 // when multiple threads are using the same Session.
 // Returns the result as a byte array, or an error.
 func (s *Session) Request(request string) ([]byte, *Error) {
+	return s.RequestContext(context.Background(), request)
+}
+
+// RequestContext is like Request, but gives up waiting for the session and
+// aborts the in-flight call when ctx is done. An aborted call leaves the
+// connection in an unknown state, so the session is closed and must be
+// connected again before reuse. The returned error has category "Context".
+func (s *Session) RequestContext(ctx context.Context, request string) ([]byte, *Error) {
+	return s.roundTrip(ctx, EncodingJSON, []byte(request))
+}
+
+// roundTrip writes the preamble for the given encoding followed by the size
+// prefixed payload, then reads back the size prefixed response.
+func (s *Session) roundTrip(ctx context.Context, encoding Encoding, request []byte) ([]byte, *Error) {
 
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
+	if err := s.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer s.release()
 
-	// RPC legacy IPC type
 	const PROTOCOL_PREAMBLE_LEAD = 'N'
-	const PROTOCOL_ENCODING = 1
 	const PROTOCOL_RESERVED = 0
 
 	var bufResponse []byte
@@ -121,6 +225,27 @@ func (s *Session) Request(request string) ([]byte, *Error) {
 	if !s.Connected {
 		errReply = &Error{1, "Not connected", "Network"}
 	} else {
+		s.deadlineMutex.Lock()
+		s.aborted = false
+		s.deadlineMutex.Unlock()
+
+		stop := make(chan struct{})
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			select {
+			case <-ctx.Done():
+				s.abort()
+			case <-stop:
+			}
+		}()
+		// The watcher must be gone before the session is released, otherwise
+		// it could abort the request of the next caller
+		defer func() {
+			close(stop)
+			<-stopped
+		}()
+
 		sc := &CallChain{}
 
 		var preamble [4]byte
@@ -129,33 +254,33 @@ func (s *Session) Request(request string) ([]byte, *Error) {
 		sc.Do(func() {
 			preamble = [4]byte{
 				PROTOCOL_PREAMBLE_LEAD,
-				PROTOCOL_ENCODING,
+				byte(encoding),
 				PROTOCOL_RESERVED,
 				PROTOCOL_RESERVED}
-			s.updateWriteDeadline()
+			s.updateWriteDeadline(ctx)
 			if _, err = s.connection.Write(preamble[:]); err != nil {
 				sc.Err = &Error{1, err.Error(), "Network"}
 			}
 		}).Do(func() {
 			binary.BigEndian.PutUint32(bufLen[:], uint32(len(request)))
-			s.updateWriteDeadline()
+			s.updateWriteDeadline(ctx)
 			if _, err = s.connection.Write(bufLen[:]); err != nil {
 				sc.Err = &Error{1, err.Error(), "Network"}
 			}
 		}).Do(func() {
-			s.updateWriteDeadline()
-			if _, err = s.connection.Write([]byte(request)); err != nil {
+			s.updateWriteDeadline(ctx)
+			if _, err = s.connection.Write(request); err != nil {
 				sc.Err = &Error{1, err.Error(), "Network"}
 			}
 		}).Do(func() {
-			// Response is big endian size followed by json response payload
-			s.updateReadDeadline()
+			// Response is big endian size followed by the response payload
+			s.updateReadDeadline(ctx)
 			if _, err = io.ReadFull(s.connection, bufLen[:]); err != nil {
 				sc.Err = &Error{1, err.Error(), "Network"}
 			}
 		}).Do(func() {
 			bufResponse = make([]byte, binary.BigEndian.Uint32(bufLen[:]))
-			s.updateReadDeadline()
+			s.updateReadDeadline(ctx)
 			if _, err = io.ReadFull(s.connection, bufResponse); err != nil {
 				sc.Err = &Error{1, err.Error(), "Network"}
 			}
@@ -163,6 +288,13 @@ func (s *Session) Request(request string) ([]byte, *Error) {
 			errReply = sc.Err
 			logger.Error(err.Error())
 		})
+
+		if errReply != nil && contextDone(ctx) != nil {
+			// The node reply, if any, is still pending on the wire
+			s.Connected = false
+			_ = s.connection.Close()
+			errReply = contextError(ctx)
+		}
 	}
 	return bufResponse, errReply
 }
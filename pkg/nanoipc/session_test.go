@@ -0,0 +1,351 @@
+package nanoipc
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/alvistar/nanopb/pkg/nanoipc/nanoapi"
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeNode is a local IPC server answering account_balance requests in both
+// the legacy JSON and the flatbuffers encoding.
+type fakeNode struct {
+	listener net.Listener
+	dir      string
+}
+
+func newFakeNode(t *testing.T) *fakeNode {
+	dir, err := ioutil.TempDir("", "nanoipc")
+	require.Nil(t, err)
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "node"))
+	require.Nil(t, err)
+
+	node := &fakeNode{listener: listener, dir: dir}
+	go node.serve()
+	return node
+}
+
+func (node *fakeNode) uri() string {
+	return "local://" + node.listener.Addr().String()
+}
+
+func (node *fakeNode) close() {
+	_ = node.listener.Close()
+	_ = os.RemoveAll(node.dir)
+}
+
+func (node *fakeNode) serve() {
+	for {
+		conn, err := node.listener.Accept()
+		if err != nil {
+			return
+		}
+		go node.handle(conn)
+	}
+}
+
+func (node *fakeNode) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var preamble [4]byte
+		var bufLen [4]byte
+		if _, err := io.ReadFull(conn, preamble[:]); err != nil {
+			return
+		}
+		if _, err := io.ReadFull(conn, bufLen[:]); err != nil {
+			return
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(bufLen[:]))
+		if _, err := io.ReadFull(conn, payload); err != nil {
+			return
+		}
+
+		if string(payload) == `{"action":"stall"}` {
+			// Never reply, until the client hangs up
+			_, _ = io.Copy(ioutil.Discard, conn)
+			return
+		}
+
+		var reply []byte
+		switch Encoding(preamble[1]) {
+		case EncodingJSON:
+			reply = jsonReply(payload)
+		case EncodingFlatbuffers:
+			reply = flatbuffersReply(payload)
+		default:
+			return
+		}
+
+		binary.BigEndian.PutUint32(bufLen[:], uint32(len(reply)))
+		_, _ = conn.Write(bufLen[:])
+		_, _ = conn.Write(reply)
+	}
+}
+
+func jsonReply(payload []byte) []byte {
+	var request map[string]string
+	if err := json.Unmarshal(payload, &request); err != nil || request["action"] != "account_balance" {
+		return []byte(`{"error":"Unknown command"}`)
+	}
+	return []byte(`{"balance":"1000","pending":"20"}`)
+}
+
+func flatbuffersReply(payload []byte) []byte {
+	envelope := nanoapi.GetRootAsEnvelope(payload, 0)
+	builder := flatbuffers.NewBuilder(0)
+
+	var msgType nanoapi.Message
+	var msg flatbuffers.UOffsetT
+
+	if envelope.MessageType() == nanoapi.MessageIsAlive {
+		nanoapi.EnvelopeStart(builder)
+		nanoapi.EnvelopeAddMessageType(builder, nanoapi.MessageError)
+		builder.Finish(nanoapi.EnvelopeEnd(builder))
+		return builder.FinishedBytes()
+	}
+
+	if envelope.MessageType() == nanoapi.MessageAccountBalance {
+		balance := builder.CreateString("1000")
+		pending := builder.CreateString("20")
+		nanoapi.AccountBalanceResponseStart(builder)
+		nanoapi.AccountBalanceResponseAddBalance(builder, balance)
+		nanoapi.AccountBalanceResponseAddPending(builder, pending)
+		msgType, msg = nanoapi.MessageAccountBalanceResponse, nanoapi.AccountBalanceResponseEnd(builder)
+	} else {
+		message := builder.CreateString("Unknown command")
+		nanoapi.ErrorStart(builder)
+		nanoapi.ErrorAddCode(builder, 2)
+		nanoapi.ErrorAddCategory(builder, 1)
+		nanoapi.ErrorAddMessage(builder, message)
+		msgType, msg = nanoapi.MessageError, nanoapi.ErrorEnd(builder)
+	}
+
+	nanoapi.EnvelopeStart(builder)
+	nanoapi.EnvelopeAddMessageType(builder, msgType)
+	nanoapi.EnvelopeAddMessage(builder, msg)
+	builder.Finish(nanoapi.EnvelopeEnd(builder))
+	return builder.FinishedBytes()
+}
+
+// accountWeightMessage builds an AccountWeight request, which the fake node does not answer
+func accountWeightMessage(account string) Message {
+	return Message{
+		Type: nanoapi.MessageAccountWeight,
+		Build: func(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+			acc := builder.CreateString(account)
+			nanoapi.AccountWeightStart(builder)
+			nanoapi.AccountWeightAddAccount(builder, acc)
+			return nanoapi.AccountWeightEnd(builder)
+		},
+	}
+}
+
+func connect(t *testing.T, node *fakeNode) *Session {
+	session := &Session{}
+	require.Nil(t, session.Connect(node.uri()))
+	return session
+}
+
+func TestMain(m *testing.M) {
+	Init(nil)
+	os.Exit(m.Run())
+}
+
+func TestRequestJSON(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	reply, err := session.Request(`{"action":"account_balance","account":"nano_1"}`)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"balance":"1000","pending":"20"}`, string(reply))
+}
+
+func TestRequestMessage(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	envelope, err := session.RequestMessage(AccountBalanceMessage("nano_1"))
+	require.Nil(t, err)
+
+	balance := nanoapi.AccountBalanceResponse{}
+	require.Nil(t, Unpack(envelope, nanoapi.MessageAccountBalanceResponse, &balance))
+	assert.Equal(t, "1000", string(balance.Balance()))
+	assert.Equal(t, "20", string(balance.Pending()))
+}
+
+func TestRequestMessageNodeError(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	envelope, err := session.RequestMessage(accountWeightMessage("nano_1"))
+	assert.Nil(t, envelope)
+	require.NotNil(t, err)
+	assert.Equal(t, 2, err.Code)
+	assert.Equal(t, "Node", err.Category)
+	assert.Equal(t, "Unknown command", err.Message)
+}
+
+func TestMixedEncodings(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	_, err := session.RequestMessage(AccountBalanceMessage("nano_1"))
+	require.Nil(t, err)
+
+	reply, err := session.Request(`{"action":"account_balance","account":"nano_1"}`)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"balance":"1000","pending":"20"}`, string(reply))
+}
+
+func TestUnpackUnexpected(t *testing.T) {
+	envelope := nanoapi.GetRootAsEnvelope(flatbuffersReply(EncodeEnvelope(AccountBalanceMessage("nano_1"))), 0)
+
+	weight := nanoapi.AccountWeightResponse{}
+	err := Unpack(envelope, nanoapi.MessageAccountWeightResponse, &weight)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Message, "AccountBalanceResponse")
+}
+
+func TestMessageLayout(t *testing.T) {
+	// The union discriminants and table slots must match the node schema
+	assert.Equal(t, nanoapi.Message(1), nanoapi.MessageAccountBalance)
+	assert.Equal(t, nanoapi.Message(2), nanoapi.MessageAccountBalanceResponse)
+	assert.Equal(t, nanoapi.Message(5), nanoapi.MessageError)
+
+	builder := flatbuffers.NewBuilder(0)
+	message := builder.CreateString("Bad account number")
+	nanoapi.ErrorStart(builder)
+	nanoapi.ErrorAddCode(builder, 1)
+	nanoapi.ErrorAddCategory(builder, 2)
+	nanoapi.ErrorAddMessage(builder, message)
+	builder.Finish(nanoapi.ErrorEnd(builder))
+
+	// code, category and message take the first three slots
+	nodeErr := nanoapi.GetRootAsError(builder.FinishedBytes(), 0)
+	table := nodeErr.Table()
+	assert.NotZero(t, table.Offset(8))
+	assert.Equal(t, "Bad account number", string(nodeErr.Message()))
+}
+
+func TestRequestMessageEmptyError(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	// An Error envelope without its table
+	envelope, err := session.RequestMessage(Message{
+		Type: nanoapi.MessageIsAlive,
+		Build: func(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+			nanoapi.IsAliveStart(builder)
+			return nanoapi.IsAliveEnd(builder)
+		},
+	})
+	assert.Nil(t, envelope)
+	require.NotNil(t, err)
+	assert.Equal(t, "Missing message in reply", err.Message)
+}
+
+func TestRequestNotConnected(t *testing.T) {
+	session := &Session{}
+	_, err := session.Request(`{"action":"version"}`)
+	require.NotNil(t, err)
+	assert.Equal(t, "Network", err.Category)
+}
+
+func TestRequestContextAbortsInFlight(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := session.RequestContext(ctx, `{"action":"stall"}`)
+	require.NotNil(t, err)
+	assert.Equal(t, "Context", err.Category)
+	assert.Equal(t, context.DeadlineExceeded.Error(), err.Message)
+	assert.True(t, time.Since(start) < time.Second)
+	assert.False(t, session.Connected)
+}
+
+func TestRequestContextCancelWhileWaiting(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	stalled, cancelStalled := context.WithCancel(context.Background())
+	go func() {
+		_, _ = session.RequestContext(stalled, `{"action":"stall"}`)
+	}()
+	defer cancelStalled()
+
+	// Let the stalled request take the session
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err := session.RequestContext(ctx, `{"action":"account_balance"}`)
+	require.NotNil(t, err)
+	assert.Equal(t, "Context", err.Category)
+	assert.Equal(t, context.Canceled.Error(), err.Message)
+}
+
+func TestRequestContextCancelAfterReply(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+	session := connect(t, node)
+	defer session.Close()
+
+	for i := 0; i < 200; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := session.RequestContext(ctx, `{"action":"account_balance"}`)
+		// gRPC cancels the context as soon as the handler returns
+		cancel()
+		require.Nil(t, err)
+
+		// The abort watcher of the previous request must not interrupt this one
+		reply, err := session.Request(`{"action":"account_balance"}`)
+		require.Nil(t, err, "request %d", i)
+		assert.JSONEq(t, `{"balance":"1000","pending":"20"}`, string(reply))
+	}
+}
+
+func TestDefaultDeadline(t *testing.T) {
+	node := newFakeNode(t)
+	defer node.close()
+
+	session := connect(t, node)
+	defer session.Close()
+
+	assert.Equal(t, 30, session.TimeoutReadWrite)
+	deadline := session.deadline(context.Background())
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), deadline, time.Second)
+
+	// A closer deadline of the context wins
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.WithinDuration(t, time.Now().Add(time.Second), session.deadline(ctx), 100*time.Millisecond)
+}